@@ -1,121 +1,649 @@
 package main
 
 import (
-	"bufio"
 	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"go/format"
 	"io/ioutil"
 	"os/exec"
-	"regexp"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
-	"time"
 
+	"github.com/benhinchley/ffmpeg/internal/listing"
 	"github.com/pinzolo/casee"
 )
 
-var regexpOption = regexp.MustCompile(`[A-Z.]{1,6}\s+([0-9a-z_]+)\s+(.*)`)
-
 type option struct {
 	Name string
 	Desc string
+
+	// codecs, encoders and decoders only
+	MediaType string
+	Props     string
+	Codec     string
+
+	// video encoders only
+	PixelFormats string
+
+	// formats only
+	Extensions        string
+	DemuxerExtensions int
+	MIMEType          string
+	VideoCodec        string
+	AudioCodec        string
+	SubtitleCodec     string
+
+	// pixel formats only
+	Components   string
+	BitsPerPixel string
+	BitDepth     int
+	Chroma       string
+
+	// sample formats only
+	BytesPerSample int
+	Planar         bool
+
+	// channel layouts only
+	Channels string
+}
+
+// generated holds the type generated from each listing, by the option of
+// ffmpeg printing it.
+var generated = map[string]struct {
+	TypeName string
+	Kind     string
+	File     string
+}{
+	"codecs":      {"Codec", "codec", "codec.go"},
+	"encoders":    {"Encoder", "encoder", "encoder.go"},
+	"decoders":    {"Decoder", "decoder", "decoder.go"},
+	"formats":     {"FileFormat", "file format", "file_format.go"},
+	"pix_fmts":    {"PixelFormat", "pixel format", "pixel_format.go"},
+	"filters":     {"Filter", "filter", "filter.go"},
+	"bsfs":        {"BitstreamFilter", "bitstream filter", "bitstream_filter.go"},
+	"protocols":   {"Protocol", "protocol", "protocol.go"},
+	"sample_fmts": {"SampleFormat", "sample format", "sample_format.go"},
+	"layouts":     {"ChannelLayout", "channel layout", "channel_layout.go"},
+	"hwaccels":    {"HWAccel", "hwaccel", "hwaccel.go"},
 }
 
-// formats
-// codecs
-// pixel formats
+var (
+	opt = flag.String("option", "", "the listing to generate from: codecs, encoders, decoders, formats, pix_fmts, filters, bsfs, protocols, sample_fmts, layouts or hwaccels")
+	dir = flag.String("dir", "", "read what ffmpeg prints from the files it was saved to in `dir` instead of running it")
+)
 
 func main() {
-	opt := flag.String("option", "", "formats, codecs, pix_fmts")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: go run _gen/main.go -option listing [-dir dir]\n\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(flag.CommandLine.Output(), "\n%s", savedUsage)
+	}
 	flag.Parse()
 
-	var running string
-	for _, o := range []string{"formats", "codecs", "pix_fmts"} {
-		if *opt == o {
-			running = *opt
-		}
+	if _, ok := generated[*opt]; !ok {
+		panic("provided invalid option")
 	}
+	out := ffmpeg("-" + *opt)
 
-	var name string
-	if running == "" {
-		panic("provided invalid option")
-	} else if running == "formats" {
-		name = "FileFormat"
-	} else if running == "codecs" {
-		name = "Codec"
-	} else if running == "pix_fmts" {
-		name = "PixelFormat"
+	switch *opt {
+	case "bsfs", "protocols", "sample_fmts", "layouts", "hwaccels":
+		sections, err := listing.ParseSections(bytes.NewReader(out))
+		if err != nil {
+			panic(fmt.Errorf("unable to parse listing: %v", err))
+		}
+		opts := sectionOptions(sections)
+		switch *opt {
+		case "sample_fmts":
+			for _, o := range opts {
+				sampleFormatInfo(o)
+			}
+			generate(opts, sampleFormatTemplate)
+		case "layouts":
+			for _, o := range opts {
+				o.Channels = fmt.Sprintf("%#v", strings.Split(o.Desc, "+"))
+			}
+			generate(opts, channelLayoutTemplate)
+		default:
+			generate(opts, "")
+		}
+		return
 	}
 
-	cmd := exec.Command("ffmpeg", "-hide_banner", "-"+running)
-	out, err := cmd.StdoutPipe()
+	entries, err := listing.Parse(bytes.NewReader(out))
 	if err != nil {
-		panic(fmt.Errorf("unable to get stdout pipe for command: %v", err))
+		panic(fmt.Errorf("unable to parse listing: %v", err))
+	}
+
+	if *opt == "formats" {
+		entries = splitFormats(entries)
 	}
 
-	r := bufio.NewScanner(out)
 	opts := []*option{}
+	kept := []listing.Entry{}
+	for _, e := range entries {
+		if strings.Contains(e.Name, ",") {
+			// formats listed under several names have no single constant name
+			continue
+		}
+		opts = append(opts, &option{
+			Name: e.Name,
+			Desc: e.Description,
+		})
+		kept = append(kept, e)
+	}
+	entries = kept
+
+	switch *opt {
+	case "codecs":
+		for i, e := range entries {
+			opts[i].MediaType = mediaTypes[e.Flags[2]]
+			opts[i].Props = codecProps(e)
+		}
+		generate(opts, codecTemplate)
+	case "encoders", "decoders":
+		for i, e := range entries {
+			opts[i].Desc = e.CodecDescription()
+			opts[i].Codec = e.Codec()
+			opts[i].Props = coderProps(e)
+			if *opt == "encoders" && e.Flags[0] == 'V' {
+				opts[i].PixelFormats = pixelFormats(help("encoder", e.Name))
+			}
+		}
+		if *opt == "encoders" {
+			// not an encoder, but accepted by ffmpeg in its place
+			opts = append([]*option{{Name: "copy", Desc: "Copy the stream without re-encoding it"}}, opts...)
+		}
+		generate(opts, coderTemplate)
+	case "formats":
+		for i, e := range entries {
+			formatInfo(opts[i], e)
+		}
+		generate(opts, formatTemplate)
+	case "pix_fmts":
+		descs := pixelFormatDescriptors()
+		for i, e := range entries {
+			desc, ok := descs[e.Name]
+			if !ok {
+				panic(fmt.Errorf("no descriptor for pixel format %s", e.Name))
+			}
+			pixelFormatInfo(opts[i], e, desc)
+		}
+		generate(opts, pixelFormatTemplate)
+	case "filters":
+		for i, e := range entries {
+			// the description starts with the media types of the inputs
+			// and outputs, such as "A->A"
+			if fields := strings.Fields(e.Description); len(fields) > 0 {
+				opts[i].Desc = strings.TrimSpace(strings.TrimPrefix(e.Description, fields[0]))
+			}
+		}
+		generate(opts, "")
+	default:
+		generate(opts, "")
+	}
+}
+
+// sectionOptions returns the constants of a listing without capability
+// column. Protocols are listed once for input and once for output, so
+// they are described by which they support.
+func sectionOptions(sections []listing.Section) []*option {
+	var opts []*option
+	byName := map[string]*option{}
+	for _, s := range sections {
+		if *opt == "layouts" && s.Title != "Standard channel layouts" {
+			// the individual channels are not layouts of their own
+			continue
+		}
+		for _, e := range s.Entries {
+			if *opt == "protocols" {
+				if o, ok := byName[e.Name]; ok {
+					o.Desc = "input and output"
+					continue
+				}
+				e.Description = strings.ToLower(s.Title)
+			}
+			o := &option{Name: e.Name, Desc: e.Description}
+			byName[e.Name] = o
+			opts = append(opts, o)
+		}
+	}
+	return opts
+}
+
+// savedUsage explains how to save what ffmpeg prints for -dir.
+const savedUsage = `With -dir, what ffmpeg prints is read from files named after its
+arguments, such as codecs.txt for "ffmpeg -hide_banner -codecs" and
+h/muxer=matroska.txt for "ffmpeg -hide_banner -h muxer=matroska". The
+version is read from version.txt, and the pixel formats printed by
+"ffprobe -v quiet -print_format json -show_pixel_formats" from
+pixel_formats.json.
+
+The files go generate reads are saved under testdata, by version of
+ffmpeg, so regenerating from them reproduces the committed tables.
+`
+
+// ffmpeg runs ffmpeg with args and returns what it wrote to stdout, or
+// reads it from the file it was saved to with -dir.
+func ffmpeg(args ...string) []byte {
+	if *dir != "" {
+		var elems []string
+		for _, arg := range args {
+			elems = append(elems, strings.TrimPrefix(arg, "-"))
+		}
+		return saved(path.Join(elems...) + ".txt")
+	}
+
+	out, err := exec.Command("ffmpeg", append([]string{"-hide_banner"}, args...)...).Output()
+	if err != nil {
+		panic(fmt.Errorf("unable to run command: %v", err))
+	}
+	return out
+}
+
+// saved returns the contents of the file name of -dir.
+func saved(name string) []byte {
+	out, err := ioutil.ReadFile(filepath.Join(*dir, filepath.FromSlash(name)))
+	if err != nil {
+		panic(fmt.Errorf("unable to read saved listing: %v", err))
+	}
+	return out
+}
+
+// version returns the version of ffmpeg, such as "3.4".
+func version() string {
+	fields := strings.Fields(string(ffmpeg("-version")))
+	if len(fields) < 3 || fields[0] != "ffmpeg" || fields[1] != "version" {
+		panic(fmt.Errorf("unexpected version of ffmpeg: %q", fields))
+	}
+	return fields[2]
+}
+
+// identifier returns the name of the constant of name, without the type
+// name, such as "Libx264" for "libx264" and "5Point1Side" for the channel
+// layout "5.1(side)".
+func identifier(name string) string {
+	return casee.ToPascalCase(strings.Replace(name, ".", " point ", -1))
+}
+
+// help returns the help of the muxer, demuxer, encoder or decoder name,
+// where kind is one of those.
+func help(kind, name string) *listing.Help {
+	h, err := listing.ParseHelp(bytes.NewReader(ffmpeg("-h", kind+"="+name)))
+	if err != nil {
+		panic(fmt.Errorf("unable to parse help of %s %s: %v", kind, name, err))
+	}
+	if h == nil {
+		panic(fmt.Errorf("no help for %s %s", kind, name))
+	}
+	return h
+}
+
+// pixelFormats returns the Go expression of the pixel formats an encoder
+// supports, or "" if it lists none.
+func pixelFormats(h *listing.Help) string {
+	var pfs []string
+	for _, pf := range h.List("Supported pixel formats") {
+		pfs = append(pfs, "PixelFormat"+identifier(pf))
+	}
+	if len(pfs) == 0 {
+		return ""
+	}
+	return "[]PixelFormat{" + strings.Join(pfs, ", ") + "}"
+}
+
+// muxerNames and demuxerNames are the names ffmpeg knows the muxer and
+// demuxer of the formats split by splitFormats by, such as "mov" for the
+// demuxer of mp4, which is listed as "mov,mp4,m4a,3gp,3g2,mj2".
+var muxerNames, demuxerNames = map[string]string{}, map[string]string{}
+
+// splitFormats lists the formats ffmpeg lists under several names under
+// each of them, merged with the format of that name listed on its own. The
+// demuxer "mov,mp4,m4a,3gp,3g2,mj2" and the muxer "mp4" make mp4 a format
+// that is both read and written.
+func splitFormats(entries []listing.Entry) []listing.Entry {
+	var split []listing.Entry
+	byName := map[string]int{}
+	for _, e := range entries {
+		if !strings.Contains(e.Name, ",") {
+			byName[e.Name] = len(split)
+			split = append(split, e)
+		}
+	}
+
+	for _, e := range entries {
+		if !strings.Contains(e.Name, ",") {
+			continue
+		}
+		names := strings.Split(e.Name, ",")
+		for _, name := range names {
+			if e.Flag(0) {
+				demuxerNames[name] = names[0]
+			}
+			if e.Flag(1) {
+				muxerNames[name] = names[0]
+			}
+
+			i, ok := byName[name]
+			if !ok {
+				byName[name] = len(split)
+				split = append(split, listing.Entry{Flags: e.Flags, Name: name, Description: e.Description})
+				continue
+			}
+			flags := []byte(split[i].Flags)
+			for f := range flags {
+				if f < len(e.Flags) && e.Flag(f) {
+					flags[f] = e.Flags[f]
+				}
+			}
+			split[i].Flags = string(flags)
+		}
+	}
+
+	// as ffmpeg lists them
+	sort.SliceStable(split, func(i, j int) bool { return split[i].Name < split[j].Name })
+	return split
+}
+
+// helpName returns the name ffmpeg knows the muxer or demuxer of a format
+// by, from names filled by splitFormats.
+func helpName(names map[string]string, name string) string {
+	if n, ok := names[name]; ok {
+		return n
+	}
+	return name
+}
+
+// formatInfo fills in the properties of a format from the help of its
+// muxer and demuxer.
+func formatInfo(opt *option, e listing.Entry) {
+	var props, extensions []string
+	opt.VideoCodec, opt.AudioCodec, opt.SubtitleCodec = "noCodec", "noCodec", "noCodec"
+
+	if e.Flag(1) {
+		props = append(props, "formatMux")
+		h := help("muxer", helpName(muxerNames, e.Name))
+		extensions = h.List("Common extensions")
+		opt.MIMEType = h.Fields["Mime type"]
+		for _, c := range []struct {
+			label string
+			codec *string
+		}{
+			{"Default video codec", &opt.VideoCodec},
+			{"Default audio codec", &opt.AudioCodec},
+			{"Default subtitle codec", &opt.SubtitleCodec},
+		} {
+			if name, ok := h.Fields[c.label]; ok {
+				*c.codec = "Codec" + identifier(name)
+			}
+		}
+	}
+	if e.Flag(0) {
+		props = append(props, "formatDemux")
+		for _, ext := range help("demuxer", helpName(demuxerNames, e.Name)).List("Common extensions") {
+			if !contains(extensions, ext) {
+				extensions = append(extensions, ext)
+				if e.Flag(1) {
+					opt.DemuxerExtensions++
+				}
+			}
+		}
+	}
+
+	opt.Props = strings.Join(props, " | ")
+	if len(extensions) > 0 {
+		opt.Extensions = fmt.Sprintf("%#v", extensions)
+	}
+}
+
+// pixelFormatDescriptor is a pixel format as printed by
+// "ffprobe -print_format json -show_pixel_formats".
+type pixelFormatDescriptor struct {
+	Name        string `json:"name"`
+	Log2ChromaW *int   `json:"log2_chroma_w"`
+	Log2ChromaH *int   `json:"log2_chroma_h"`
+	Flags       struct {
+		Planar int `json:"planar"`
+		RGB    int `json:"rgb"`
+		Alpha  int `json:"alpha"`
+	} `json:"flags"`
+	Components []struct {
+		BitDepth int `json:"bit_depth"`
+	} `json:"components"`
+}
+
+// pixelFormatDescriptors returns the descriptors of the pixel formats by
+// name. ffmpeg only lists some of their properties, so they are read from
+// ffprobe.
+func pixelFormatDescriptors() map[string]pixelFormatDescriptor {
+	var out []byte
+	if *dir != "" {
+		out = saved("pixel_formats.json")
+	} else {
+		var err error
+		out, err = exec.Command("ffprobe", "-v", "quiet", "-print_format", "json", "-show_pixel_formats").Output()
+		if err != nil {
+			panic(fmt.Errorf("unable to run command: %v", err))
+		}
+	}
+	var v struct {
+		PixelFormats []pixelFormatDescriptor `json:"pixel_formats"`
+	}
+	if err := json.Unmarshal(out, &v); err != nil {
+		panic(fmt.Errorf("unable to parse pixel formats: %v", err))
+	}
 
-	if err := cmd.Start(); err != nil {
-		panic(fmt.Errorf("unable to start command: %v", err))
+	descs := map[string]pixelFormatDescriptor{}
+	for _, d := range v.PixelFormats {
+		descs[d.Name] = d
 	}
+	return descs
+}
+
+// chromaSubsamplings are the J:a:b notations of the chroma subsamplings,
+// by the log2 of their horizontal and vertical ratios.
+var chromaSubsamplings = map[[2]int]string{
+	{0, 0}: "4:4:4",
+	{1, 0}: "4:2:2",
+	{1, 1}: "4:2:0",
+	{2, 0}: "4:1:1",
+	{2, 2}: "4:1:0",
+	{0, 1}: "4:4:0",
+}
 
-	for r.Scan() {
-		line := strings.TrimSpace(r.Text())
-		if regexpOption.MatchString(line) {
-			matches := regexpOption.FindAllStringSubmatch(line, -1)
-			opts = append(opts, &option{
-				Name: matches[0][1],
-				Desc: matches[0][2],
-			})
+// pixelFormatInfo fills in the properties of a pixel format from its
+// listing entry and descriptor.
+func pixelFormatInfo(opt *option, e listing.Entry, d pixelFormatDescriptor) {
+	var props []string
+	for _, p := range []struct {
+		set  bool
+		prop string
+	}{
+		{e.Flag(0), "pixelFormatInput"},
+		{e.Flag(1), "pixelFormatOutput"},
+		{e.Flag(2), "pixelFormatHWAccel"},
+		{e.Flag(3), "pixelFormatPaletted"},
+		{e.Flag(4), "pixelFormatBitstream"},
+		{d.Flags.Planar != 0, "pixelFormatPlanar"},
+		{d.Flags.RGB != 0, "pixelFormatRGB"},
+		{d.Flags.Alpha != 0, "pixelFormatAlpha"},
+	} {
+		if p.set {
+			props = append(props, p.prop)
 		}
 	}
+	opt.Props = "0"
+	if len(props) > 0 {
+		opt.Props = strings.Join(props, " | ")
+	}
+
+	// the description holds the component count and bits per pixel
+	fields := strings.Fields(e.Description)
+	if len(fields) != 2 {
+		panic(fmt.Errorf("unexpected description of pixel format %s: %q", e.Name, e.Description))
+	}
+	opt.Components, opt.BitsPerPixel = fields[0], fields[1]
 
-	if err := cmd.Wait(); err != nil {
-		panic(fmt.Errorf("unable to wait for command to finish: %v", err))
+	for _, c := range d.Components {
+		if c.BitDepth > opt.BitDepth {
+			opt.BitDepth = c.BitDepth
+		}
+	}
+	if d.Log2ChromaW != nil && d.Log2ChromaH != nil {
+		opt.Chroma = chromaSubsamplings[[2]int{*d.Log2ChromaW, *d.Log2ChromaH}]
+	}
+}
+
+// sampleFormatInfo fills in the properties of a sample format from its
+// name and the depth it is described by.
+func sampleFormatInfo(opt *option) {
+	depth, err := strconv.Atoi(opt.Desc)
+	if err != nil {
+		panic(fmt.Errorf("unexpected depth of sample format %s: %q", opt.Name, opt.Desc))
+	}
+	opt.BytesPerSample = depth / 8
+	// ffmpeg names the planar sample formats after the packed ones, with
+	// a "p" appended
+	opt.Planar = strings.HasSuffix(opt.Name, "p")
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+var mediaTypes = map[byte]string{
+	'V': "StreamTypeVideo",
+	'A': "StreamTypeAudio",
+	'S': "StreamTypeSubtitle",
+	'D': "StreamTypeData",
+	'T': "StreamTypeAttachment",
+}
+
+// codecProps returns the Go expression of the capabilities of a codec.
+func codecProps(e listing.Entry) string {
+	var props []string
+	for _, p := range []struct {
+		flag int
+		prop string
+	}{
+		{0, "codecDecode"},
+		{1, "codecEncode"},
+		{3, "codecIntraOnly"},
+		{4, "codecLossy"},
+		{5, "codecLossless"},
+	} {
+		if e.Flag(p.flag) {
+			props = append(props, p.prop)
+		}
+	}
+	if len(props) == 0 {
+		return "0"
+	}
+	return strings.Join(props, " | ")
+}
+
+// coderProps returns the Go expression of the capabilities of an encoder
+// or decoder.
+func coderProps(e listing.Entry) string {
+	var props []string
+	for _, p := range []struct {
+		flag int
+		prop string
+	}{
+		{1, "coderFrameThreads"},
+		{2, "coderSliceThreads"},
+		{3, "coderExperimental"},
+	} {
+		if e.Flag(p.flag) {
+			props = append(props, p.prop)
+		}
+	}
+	if len(props) == 0 {
+		return "0"
+	}
+	return strings.Join(props, " | ")
+}
+
+// generate writes the file of the type generated from the listing of -option
+// with the constants opts. extra is a template executed after the type.
+//
+// The file only depends on the listing and the version of ffmpeg, so it
+// is unchanged when generated again from the same ffmpeg.
+func generate(opts []*option, extra string) {
+	gen := generated[*opt]
+
+	// constants named after different names would not compile, or worse,
+	// silently refer to another listed name
+	idents := map[string]string{}
+	for _, o := range opts {
+		id := identifier(o.Name)
+		if other, ok := idents[id]; ok {
+			panic(fmt.Errorf("%s %q and %q are both named %s%s", gen.Kind, other, o.Name, gen.TypeName, id))
+		}
+		idents[id] = o.Name
 	}
 
 	t := struct {
-		Timestamp time.Time
-		TypeName  string
-		Options   []*option
+		Version  string
+		TypeName string
+		Kind     string
+		Options  []*option
 	}{
-		Timestamp: time.Now(),
-		TypeName:  casee.ToPascalCase(name),
-		Options:   opts,
+		Version:  version(),
+		TypeName: gen.TypeName,
+		Kind:     gen.Kind,
+		Options:  opts,
 	}
 
 	tmpl, err := template.New("ffmpeg type").Funcs(template.FuncMap{
-		"pascal": casee.ToPascalCase,
-	}).Parse(strings.TrimSpace(typeTemplate))
+		"ident": identifier,
+		"lower": strings.ToLower,
+	}).Parse(strings.TrimSpace(typeTemplate) + "\n" + extra)
 	if err != nil {
 		panic(fmt.Errorf("unable to create template: %v", err))
 	}
 
 	var contents bytes.Buffer
-	tmpl.Execute(&contents, t)
-	if err := ioutil.WriteFile(casee.ToSnakeCase(name)+".go", contents.Bytes(), 0777); err != nil {
+	if err := tmpl.Execute(&contents, t); err != nil {
 		panic(fmt.Errorf("unable to execute template: %v", err))
 	}
+	src, err := format.Source(contents.Bytes())
+	if err != nil {
+		panic(fmt.Errorf("unable to format %s: %v", gen.File, err))
+	}
+	if err := ioutil.WriteFile(gen.File, src, 0644); err != nil {
+		panic(fmt.Errorf("unable to write file: %v", err))
+	}
 }
 
 var typeTemplate = `
 {{- $Type := .TypeName -}}
-// Code generated by go generate; DO NOT EDIT.
-// This code was generated by robots at
-// {{.Timestamp}}
+// Code generated by go generate from ffmpeg {{.Version}}; DO NOT EDIT.
 
 package ffmpeg
 
+// {{$Type}} values are numbered in the order ffmpeg lists them, so they
+// change whenever this file is regenerated, such as when a name ffmpeg
+// lists with others is split out. Persist the name from MarshalText rather
+// than the number.
 type {{$Type}} int
 
 const (
 	{{- range $i, $elem := .Options}}
 	{{- if eq $i 0}}
-	{{$Type}}{{pascal $elem.Name}} {{$Type}} = iota // {{$elem.Desc}}
+	{{$Type}}{{ident $elem.Name}} {{$Type}} = iota{{if $elem.Desc}} // {{$elem.Desc}}{{end}}
 	{{- else}}
-	{{$Type}}{{pascal $elem.Name}} // {{$elem.Desc}}
+	{{$Type}}{{ident $elem.Name}}{{if $elem.Desc}} // {{$elem.Desc}}{{end}}
 	{{- end}}
 	{{- end}}
 )
@@ -123,10 +651,131 @@ const (
 func (typ {{$Type}}) String() string {
 	switch typ {
 	{{- range .Options}}
-	case {{$Type}}{{pascal .Name}}:
+	case {{$Type}}{{ident .Name}}:
 		return "{{.Name}}"
 	{{- end}}
 	}
 	return ""
 }
+
+// All{{$Type}}s returns all the {{$Type}} constants, in the order ffmpeg
+// lists them.
+func All{{$Type}}s() []{{$Type}} {
+	all := make([]{{$Type}}, 0, {{len .Options}})
+	for typ := {{$Type}}(0); typ.String() != ""; typ++ {
+		all = append(all, typ)
+	}
+	return all
+}
+
+// Parse{{$Type}} returns the {{$Type}} named name, such as "{{(index .Options 0).Name}}".
+func Parse{{$Type}}(name string) ({{$Type}}, error) {
+	var names []string
+	for _, typ := range All{{$Type}}s() {
+		if typ.String() == name {
+			return typ, nil
+		}
+		names = append(names, typ.String())
+	}
+	return 0, unknownName("{{.Kind}}", name, names)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (typ {{$Type}}) MarshalText() ([]byte, error) {
+	if typ.String() == "" {
+		return nil, invalidValue("{{.Kind}}", int(typ))
+	}
+	return []byte(typ.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (typ *{{$Type}}) UnmarshalText(text []byte) error {
+	return typ.Set(string(text))
+}
+
+// Set implements flag.Value.
+func (typ *{{$Type}}) Set(name string) error {
+	parsed, err := Parse{{$Type}}(name)
+	if err != nil {
+		return err
+	}
+	*typ = parsed
+	return nil
+}
+`
+
+var codecTemplate = `
+// codecInfos holds the capabilities of each Codec, as listed by ffmpeg.
+var codecInfos = [...]codecInfo{
+	{{- range .Options}}
+	Codec{{ident .Name}}: {mediaType: {{.MediaType}}, props: {{.Props}}},
+	{{- end}}
+}
+`
+
+var formatTemplate = `
+// fileFormatInfos holds the muxer and demuxer properties of each
+// FileFormat, as listed by ffmpeg.
+var fileFormatInfos = [...]fileFormatInfo{
+	{{- range .Options}}
+	FileFormat{{ident .Name}}: {
+		props: {{.Props}},
+		{{- if .Extensions}}
+		extensions: {{.Extensions}},
+		{{- end}}
+		{{- if .DemuxerExtensions}}
+		demuxerExtensions: {{.DemuxerExtensions}},
+		{{- end}}
+		{{- if .MIMEType}}
+		mimeType: {{printf "%q" .MIMEType}},
+		{{- end}}
+		videoCodec:    {{.VideoCodec}},
+		audioCodec:    {{.AudioCodec}},
+		subtitleCodec: {{.SubtitleCodec}},
+	},
+	{{- end}}
+}
+`
+
+var pixelFormatTemplate = `
+// pixelFormatInfos holds the properties of each PixelFormat, as listed by
+// ffmpeg and ffprobe.
+var pixelFormatInfos = [...]pixelFormatInfo{
+	{{- range .Options}}
+	PixelFormat{{ident .Name}}: {props: {{.Props}}, components: {{.Components}}, bitsPerPixel: {{.BitsPerPixel}}, bitDepth: {{.BitDepth}}{{if .Chroma}}, chroma: "{{.Chroma}}"{{end}}},
+	{{- end}}
+}
+`
+
+var coderTemplate = `
+{{- $Type := .TypeName}}
+// {{lower $Type}}Infos holds the codec and capabilities of each {{$Type}}, as
+// listed by ffmpeg.
+var {{lower $Type}}Infos = [...]coderInfo{
+	{{- range .Options}}
+	{{- if .Codec}}
+	{{$Type}}{{ident .Name}}: {codec: Codec{{ident .Codec}}, props: {{.Props}}{{if .PixelFormats}}, pixelFormats: {{.PixelFormats}}{{end}}},
+	{{- end}}
+	{{- end}}
+}
+`
+
+var sampleFormatTemplate = `
+// sampleFormatInfos holds the properties of each SampleFormat, as listed
+// by ffmpeg.
+var sampleFormatInfos = [...]sampleFormatInfo{
+	{{- range .Options}}
+	SampleFormat{{ident .Name}}: {bytesPerSample: {{.BytesPerSample}}{{if .Planar}}, planar: true{{end}}},
+	{{- end}}
+}
+`
+
+var channelLayoutTemplate = `
+// channelLayoutInfos holds the channels of each ChannelLayout, as listed
+// by ffmpeg.
+var channelLayoutInfos = [...]channelLayoutInfo{
+	{{- range .Options}}
+	ChannelLayout{{ident .Name}}: {channels: {{.Channels}}},
+	{{- end}}
+}
 `
@@ -0,0 +1,258 @@
+package ffmpeg
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// Capabilities describes how the installed ffmpeg binary was built.
+type Capabilities struct {
+	// Version is the version of ffmpeg.
+	Version Version
+
+	// Libraries holds the external libraries ffmpeg was built with, as
+	// named by their configure flags, such as "libx264" or "libfdk-aac".
+	Libraries []string
+
+	// Configuration holds the flags ffmpeg was configured with, such as
+	// "--enable-gpl".
+	Configuration []string
+}
+
+// Version is the version of an ffmpeg binary.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+
+	// Git is the git revision of builds made from a snapshot rather than
+	// a release, such as "N-90000-gabcdef0". The version numbers are zero
+	// for them.
+	Git string
+
+	// Raw is the version as printed by ffmpeg, such as "4.4.2-0ubuntu0.22.04.1".
+	Raw string
+}
+
+// regexpVersion matches release versions, such as "3.4", "n4.4" or
+// "4.4.2-0ubuntu0.22.04.1".
+var regexpVersion = regexp.MustCompile(`^n?(\d+)\.(\d+)(?:\.(\d+))?`)
+
+func parseVersion(raw string) Version {
+	v := Version{Raw: raw}
+	m := regexpVersion.FindStringSubmatch(raw)
+	if m == nil {
+		v.Git = raw
+		return v
+	}
+	v.Major, _ = strconv.Atoi(m[1])
+	v.Minor, _ = strconv.Atoi(m[2])
+	v.Patch, _ = strconv.Atoi(m[3])
+	return v
+}
+
+func (v Version) String() string {
+	return v.Raw
+}
+
+// AtLeast reports whether v is major.minor or later. Snapshot builds are
+// assumed to be later than any release.
+func (v Version) AtLeast(major, minor int) bool {
+	if v.Git != "" {
+		return true
+	}
+	return v.Major > major || (v.Major == major && v.Minor >= minor)
+}
+
+// HasLibrary reports whether ffmpeg was built with the external library
+// name, such as "libx264". Dashes and underscores are treated alike, so
+// "libfdk_aac" matches "libfdk-aac".
+func (c *Capabilities) HasLibrary(name string) bool {
+	name = normalizeLibrary(name)
+	for _, lib := range c.Libraries {
+		if normalizeLibrary(lib) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Enabled reports whether ffmpeg was configured with "--enable-" feature,
+// such as "opencl" or "gpl".
+func (c *Capabilities) Enabled(feature string) bool {
+	enabled := false
+	for _, flag := range c.Configuration {
+		switch flag {
+		case "--enable-" + feature:
+			enabled = true
+		case "--disable-" + feature:
+			enabled = false
+		}
+	}
+	return enabled
+}
+
+func normalizeLibrary(name string) string {
+	return strings.Replace(name, "-", "_", -1)
+}
+
+// DetectCapabilities detects the capabilities of the ffmpeg binary used by
+// DefaultRunner.
+func DetectCapabilities() (*Capabilities, error) {
+	return DefaultRunner.DetectCapabilities()
+}
+
+// DetectCapabilities detects the capabilities of the ffmpeg binary of the
+// runner by running "ffmpeg -version" and "ffmpeg -buildconf".
+//
+// The result is cached, so ffmpeg is only run the first time it succeeds.
+func (r *Runner) DetectCapabilities() (*Capabilities, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.caps != nil {
+		return r.caps, nil
+	}
+
+	version, err := r.output("-version")
+	if err != nil {
+		return nil, fmt.Errorf("unable to detect ffmpeg version: %v", err)
+	}
+	buildconf, err := r.output("-buildconf")
+	if err != nil {
+		return nil, fmt.Errorf("unable to detect ffmpeg build configuration: %v", err)
+	}
+
+	caps := &Capabilities{}
+	if err := caps.parseVersion(version); err != nil {
+		return nil, err
+	}
+	caps.parseBuildconf(buildconf)
+
+	r.caps = caps
+	return caps, nil
+}
+
+// output runs ffmpeg with args and returns what it wrote to stdout.
+func (r *Runner) output(args ...string) ([]byte, error) {
+	var stdout bytes.Buffer
+	cmd := &Cmd{
+		Args:     append([]string{"-hide_banner", "-nostdin"}, args...),
+		path:     r.path(),
+		env:      r.env(),
+		dir:      r.Dir,
+		ctx:      context.Background(),
+		executor: r.executor(),
+		stdout:   &stdout,
+	}
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+// parseVersion parses the output of "ffmpeg -version", whose first line
+// looks like:
+//
+//	ffmpeg version 3.4 Copyright (c) 2000-2017 the FFmpeg developers
+func (c *Capabilities) parseVersion(b []byte) error {
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) >= 3 && fields[0] == "ffmpeg" && fields[1] == "version" {
+			c.Version = parseVersion(fields[2])
+			return nil
+		}
+	}
+	return fmt.Errorf("unable to detect ffmpeg version: unexpected output %q", b)
+}
+
+// parseBuildconf parses the output of "ffmpeg -buildconf", which lists the
+// configure flags one per line:
+//
+//	configuration:
+//	  --prefix=/usr/local/Cellar/ffmpeg/3.4
+//	  --enable-shared
+func (c *Capabilities) parseBuildconf(b []byte) {
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		flag := strings.TrimSpace(s.Text())
+		if !strings.HasPrefix(flag, "--") {
+			continue
+		}
+		c.Configuration = append(c.Configuration, flag)
+	}
+
+	for _, flag := range c.Configuration {
+		if lib := strings.TrimPrefix(flag, "--enable-"); strings.HasPrefix(lib, "lib") && c.Enabled(lib) && !c.HasLibrary(lib) {
+			c.Libraries = append(c.Libraries, lib)
+		}
+	}
+}
+
+// codecFlags are the flags selecting a codec, without stream specifier.
+var codecFlags = map[string]bool{
+	"c": true, "codec": true, "vcodec": true, "acodec": true, "scodec": true,
+}
+
+// codecLibraries are the external libraries of the codecs that are not
+// named after them exactly. Other codecs starting with "lib" are.
+var codecLibraries = map[string]string{
+	"libx264rgb":       "libx264",
+	"libvpx-vp9":       "libvpx",
+	"libaom-av1":       "libaom",
+	"libwebp_anim":     "libwebp",
+	"libgsm_ms":        "libgsm",
+	"libzvbi_teletext": "libzvbi",
+}
+
+// check returns an error for each of the flags in args the build can not
+// honour.
+func (c *Capabilities) check(args []string) error {
+	var errs *multierror.Error
+	for i := 0; i < len(args); i++ {
+		flag := args[i]
+		if flag == "-" || !strings.HasPrefix(flag, "-") {
+			// a file
+			continue
+		}
+		name := strings.SplitN(flag[1:], ":", 2)[0]
+		if isBooleanFlag(name) || i+1 == len(args) {
+			continue
+		}
+		i++
+		value := args[i]
+
+		switch {
+		case name == "opencl_options":
+			if !c.Enabled("opencl") {
+				errs = multierror.Append(errs, fmt.Errorf("unable to apply -opencl_options flag: ffmpeg %s not configured with --enable-opencl", c.Version))
+			}
+		case codecFlags[name] && strings.HasPrefix(value, "lib"):
+			lib, ok := codecLibraries[value]
+			if !ok {
+				lib = value
+			}
+			if !c.HasLibrary(lib) {
+				errs = multierror.Append(errs, fmt.Errorf("unable to apply %s flag: ffmpeg %s not built with %s", flag, c.Version, lib))
+			}
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// checkInput returns an error for each of the options of the input file f
+// the build can not honour.
+func (c *Capabilities) checkInput(f *File) error {
+	if _, ok := f.positions["to"]; ok && !c.Version.AtLeast(4, 0) {
+		return fmt.Errorf("unable to apply -to flag: ffmpeg %s does not support it on input files", c.Version)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package ffmpeg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benhinchley/ffmpeg/ffmpegtest"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		Raw      string
+		Expected Version
+	}{
+		{"3.4", Version{Major: 3, Minor: 4, Raw: "3.4"}},
+		{"n4.4", Version{Major: 4, Minor: 4, Raw: "n4.4"}},
+		{"4.4.2-0ubuntu0.22.04.1", Version{Major: 4, Minor: 4, Patch: 2, Raw: "4.4.2-0ubuntu0.22.04.1"}},
+		{"N-90000-gabcdef0", Version{Git: "N-90000-gabcdef0", Raw: "N-90000-gabcdef0"}},
+	}
+
+	for _, test := range tests {
+		if got := parseVersion(test.Raw); got != test.Expected {
+			t.Errorf("Expected %+v got %+v", test.Expected, got)
+		}
+	}
+
+	if !parseVersion("4.4.2").AtLeast(4, 1) || parseVersion("3.4").AtLeast(4, 0) || !parseVersion("N-1-gabc").AtLeast(6, 0) {
+		t.Errorf("Unexpected AtLeast result")
+	}
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{
+		Version: "4.4.2",
+		Configuration: []string{
+			"--prefix=/usr",
+			"--extra-cflags=-I/opt/include -O2",
+			"--enable-gpl",
+			"--enable-libx264",
+			"--enable-libfdk-aac",
+			"--enable-libvpx",
+			"--disable-libvpx",
+		},
+	})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	caps, err := r.DetectCapabilities()
+	if err != nil {
+		t.Fatalf("unable to detect capabilities: %v", err)
+	}
+	if caps.Version.Major != 4 || caps.Version.Minor != 4 {
+		t.Errorf("Expected version 4.4 got %s", caps.Version)
+	}
+	if expected := []string{"libx264", "libfdk-aac"}; !reflect.DeepEqual(caps.Libraries, expected) {
+		t.Errorf("Expected %q got %q", expected, caps.Libraries)
+	}
+	if len(caps.Configuration) != 7 || caps.Configuration[1] != "--extra-cflags=-I/opt/include -O2" {
+		t.Errorf("Unexpected configuration %q", caps.Configuration)
+	}
+	if !caps.HasLibrary("libfdk_aac") || caps.HasLibrary("libvpx") || !caps.Enabled("gpl") || caps.Enabled("opencl") {
+		t.Errorf("Unexpected capabilities %+v", caps)
+	}
+
+	if again, err := r.DetectCapabilities(); err != nil || again != caps {
+		t.Errorf("Expected cached capabilities got %+v (%v)", again, err)
+	}
+	if calls := stub.Calls(); len(calls) != 2 {
+		t.Errorf("Expected ffmpeg to be run 2 times got %d", len(calls))
+	}
+}
+
+func TestRunnerCapabilities(t *testing.T) {
+	caps := &Capabilities{
+		Version:       parseVersion("3.4"),
+		Libraries:     []string{"libx264"},
+		Configuration: []string{"--enable-libx264"},
+	}
+	r := &Runner{Capabilities: caps}
+
+	tests := []struct {
+		Global GlobalOptions
+		Input  *File
+		File   *File
+		Error  string
+	}{
+		{nil, nil, Output("out.mp4", withFlags("-c:v", "libx264rgb")), ""},
+		{nil, nil, Output("out.mp4", withFlags("-c:v", "h264")), ""},
+		{nil, nil, Output("out.mp4", withFlags("-c:a", "libfdk_aac")), "not built with libfdk_aac"},
+		{nil, nil, Output("out.mp4", withFlags("-c:v", "libx26")), "not built with libx26"},
+		{nil, nil, Output("out.mp4", withFlags("-c:v", "libx264-10bit")), "not built with libx264-10bit"},
+		{nil, nil, Output("out.mp4", withFlags("-c:v", "libvpx-vp9")), "not built with libvpx"},
+		{nil, nil, Output("out.mp4", withFlags("-vcodec", "libx264")), ""},
+		{nil, nil, Output("libfoo.mp4", WithMetadata(GlobalMetadataScope(), "comment", "libfdk_aac")), ""},
+		{nil, Input("libfoo.mp4"), Output("out.mp4", withFlags("-an", "-c:v", "libx264")), ""},
+		{GlobalOptions{WithOpenCLOptions(map[string]string{"platform_idx": "0"})}, nil, Output("out.mp4"), "--enable-opencl"},
+		{nil, nil, Output("out.mp4", WithEndPosition(time.Minute)), ""},
+		{nil, Input("in.mp4", WithEndPosition(time.Minute)), Output("out.mp4"), "does not support it on input files"},
+	}
+
+	for _, test := range tests {
+		input := test.Input
+		if input == nil {
+			input = Input("in.mp4")
+		}
+		_, err := r.Command(test.Global, input, test.File)
+		switch {
+		case test.Error == "" && err != nil:
+			t.Errorf("unable to create command: %v", err)
+		case test.Error != "" && (err == nil || !strings.Contains(err.Error(), test.Error)):
+			t.Errorf("Expected error containing %q got %v", test.Error, err)
+		}
+	}
+}
+
+func withFlags(flags ...string) FileOption {
+	return func(f *File) error {
+		f.options = append(f.options, flags...)
+		return nil
+	}
+}
@@ -0,0 +1,24 @@
+package ffmpeg
+
+type channelLayoutInfo struct {
+	channels []string
+}
+
+func (typ ChannelLayout) info() channelLayoutInfo {
+	if typ < 0 || int(typ) >= len(channelLayoutInfos) {
+		return channelLayoutInfo{}
+	}
+	return channelLayoutInfos[typ]
+}
+
+// Channels returns the names of the channels of the layout, in the order
+// ffmpeg stores them, such as FL and FR for stereo.
+func (typ ChannelLayout) Channels() []string {
+	return typ.info().channels
+}
+
+// NumChannels returns the number of channels of the layout, such as 6 for
+// 5.1.
+func (typ ChannelLayout) NumChannels() int {
+	return len(typ.info().channels)
+}
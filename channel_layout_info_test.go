@@ -0,0 +1,29 @@
+package ffmpeg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChannelLayoutInfo(t *testing.T) {
+	tests := []struct {
+		ChannelLayout ChannelLayout
+		Channels      []string
+	}{
+		{ChannelLayoutMono, []string{"FC"}},
+		{ChannelLayoutStereo, []string{"FL", "FR"}},
+		{ChannelLayout5Point1, []string{"FL", "FR", "FC", "LFE", "BL", "BR"}},
+		{ChannelLayout7Point1WideSide, []string{"FL", "FR", "FC", "LFE", "FLC", "FRC", "SL", "SR"}},
+		{ChannelLayout(-1), nil},
+	}
+
+	for _, test := range tests {
+		cl := test.ChannelLayout
+		if got := cl.Channels(); !reflect.DeepEqual(got, test.Channels) {
+			t.Errorf("%s: Expected channels %q got %q", cl, test.Channels, got)
+		}
+		if got := cl.NumChannels(); got != len(test.Channels) {
+			t.Errorf("%s: Expected %d channels got %d", cl, len(test.Channels), got)
+		}
+	}
+}
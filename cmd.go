@@ -1,32 +1,506 @@
 package ffmpeg
 
 import (
-	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log/slog"
 	"os"
-	"os/exec"
+	"sync"
+	"syscall"
+	"time"
 )
 
+// DefaultGracePeriod is how long ffmpeg is given to finalize its output
+// after being asked to stop, before it is killed.
+const DefaultGracePeriod = 10 * time.Second
+
+// errorLogLines is the number of log lines kept for an *Error.
+const errorLogLines = 20
+
 // Cmd represents an ffmpeg command being prepared or run.
 type Cmd struct {
 	Args []string
 
-	cmd *exec.Cmd
+	// GracePeriod is how long ffmpeg is given to finish writing its output
+	// once the command's context is done, before the process is killed.
+	// If zero, DefaultGracePeriod is used.
+	//
+	// On platforms other than unix ffmpeg can only be asked to stop on its
+	// stdin, so with "-nostdin", as in DefaultBaseFlags, it is killed at
+	// once and the output is truncated.
+	GracePeriod time.Duration
+
+	// LogHandler receives each line ffmpeg logs as a record, at the level
+	// ffmpeg logged it with and with the component that emitted it, such
+	// as "libx264", as the "component" attribute. It is usually set with
+	// Runner.LogHandler, and must be set before the command is started. If
+	// nil, log lines are only kept to describe a failure.
+	LogHandler slog.Handler
+
+	path     string
+	env      []string
+	dir      string
+	ctx      context.Context
+	executor Executor
+	stdout   io.Writer
+
+	pipes        []*pipe
+	progress     chan Progress
+	progressOnce sync.Once // guards reading progress into, or closing, progress
+
+	mu             sync.Mutex
+	proc           Process
+	waited         bool
+	stdin          io.WriteCloser
+	stderr         *logTail
+	log            *lineWriter
+	closeAfterWait []io.Closer
+	done           chan struct{} // closed once ffmpeg exited and pipes are copied
+	err            error         // set before done is closed
+	watched        chan struct{} // closed once the context is no longer watched
+	interrupted    *InterruptError
+}
+
+// Errors returned when a Cmd's methods are called out of order.
+var (
+	ErrNotStarted     = errors.New("ffmpeg: not started")
+	ErrAlreadyStarted = errors.New("ffmpeg: already started")
+	ErrAlreadyWaited  = errors.New("ffmpeg: Wait was already called")
+
+	errPauseUnsupported     = errors.New("ffmpeg: pausing is not supported on this platform")
+	errInterruptUnsupported = errors.New("ffmpeg: interrupting is not supported on this platform")
+)
+
+// InterruptError is returned when a command is stopped because its
+// context was done before ffmpeg exited.
+type InterruptError struct {
+	// Err is the error of the context that stopped the command.
+	Err error
+	// Finalized reports whether ffmpeg exited on its own after being asked
+	// to stop, meaning the output container was written out completely.
+	// When false ffmpeg had to be killed and the output is likely truncated.
+	Finalized bool
+}
+
+func (e *InterruptError) Error() string {
+	if e.Finalized {
+		return fmt.Sprintf("ffmpeg interrupted, output finalized: %v", e.Err)
+	}
+	return fmt.Sprintf("ffmpeg killed, output truncated: %v", e.Err)
+}
+
+// Unwrap returns the context error that stopped the command.
+func (e *InterruptError) Unwrap() error {
+	return e.Err
 }
 
 // Run starts the specified command and waits for it to complete.
+//
+// If ffmpeg exits unsuccessfully the returned error is an *Error.
+//
+// If the command was created with CommandContext, the command is stopped
+// when the context is done. See RunContext.
 func (cmd *Cmd) Run() error {
-	var stderr bytes.Buffer
-	cmd.cmd.Stderr = &stderr
-	err := cmd.cmd.Run()
-	if err != nil {
-		switch err.(type) {
-		case *exec.ExitError:
-			fmt.Fprintf(os.Stderr, "%s\n", stderr.String())
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	return cmd.Wait()
+}
+
+// RunContext starts the specified command and waits for it to complete,
+// stopping it when ctx is done instead of the context the command was
+// created with.
+//
+// On cancellation ffmpeg is first asked to stop cleanly, so it can finalize
+// the output container: by sending "q" on stdin, or an interrupt signal when
+// stdin interaction is disabled with "-nostdin". If ffmpeg has not exited
+// after the grace period it is killed. The returned error is then an
+// *InterruptError reporting whether the output was finalized.
+//
+// Interrupt signals are only supported on unix; elsewhere a command with
+// "-nostdin" is killed without a grace period.
+func (cmd *Cmd) RunContext(ctx context.Context) error {
+	cmd.ctx = ctx
+	return cmd.Run()
+}
+
+// Start starts the specified command but does not wait for it to complete.
+//
+// After a successful call to Start the Wait method must be called in
+// order to release associated system resources.
+func (cmd *Cmd) Start() (err error) {
+	cmd.mu.Lock()
+	defer cmd.mu.Unlock()
+
+	if cmd.proc != nil {
+		return ErrAlreadyStarted
+	}
+	// nothing will be reported, so receivers must not wait for it
+	defer func() {
+		if err != nil && cmd.progress != nil {
+			cmd.progressOnce.Do(func() { close(cmd.progress) })
+		}
+	}()
+
+	ctx := cmd.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	cmd.stderr = &logTail{max: errorLogLines}
+	cmd.log = &lineWriter{fn: cmd.stderr.add}
+	if cmd.LogHandler != nil {
+		cmd.Args = withLevelPrefix(cmd.Args)
+		rec := &logRecorder{ctx: ctx, handler: cmd.LogHandler}
+		cmd.log.fn = func(line string) {
+			l := parseLogLine(line)
+			cmd.stderr.add(l.String())
+			rec.record(l)
+		}
+	}
+
+	inv := &Invocation{
+		Path:   cmd.path,
+		Args:   cmd.Args,
+		Env:    cmd.env,
+		Dir:    cmd.dir,
+		Stdout: cmd.stdout,
+		Stderr: cmd.log,
+	}
+
+	// the process gets its own copies of these, closing ours once it has
+	// started lets readers see EOF when ffmpeg exits
+	var closeAfterStart []io.Closer
+	defer func() {
+		for _, c := range closeAfterStart {
+			c.Close()
+		}
+	}()
+	// the caller's ends are kept until the command completes
+	var closeAfterWait []io.Closer
+	defer func() {
+		if cmd.proc == nil {
+			for _, c := range closeAfterWait {
+				c.Close()
+			}
+		}
+	}()
+
+	var local []*os.File
+	for _, p := range cmd.pipes {
+		r, w, err := os.Pipe()
+		if err != nil {
 			return err
-		default:
+		}
+
+		l, remote := r, w
+		if p.input {
+			l, remote = w, r
+		}
+		local = append(local, l)
+		closeAfterWait = append(closeAfterWait, l)
+		closeAfterStart = append(closeAfterStart, remote)
+		inv.ExtraFiles = append(inv.ExtraFiles, remote)
+	}
+
+	if !cmd.hasFlag("-nostdin") {
+		r, w, err := os.Pipe()
+		if err != nil {
 			return err
 		}
+		closeAfterWait = append(closeAfterWait, w)
+		closeAfterStart = append(closeAfterStart, r)
+
+		inv.Stdin, cmd.stdin = r, w
+	}
+
+	proc, err := cmd.executor.Start(inv)
+	if err != nil {
+		return err
 	}
+	cmd.proc = proc
+	cmd.closeAfterWait = closeAfterWait
+
+	var copying sync.WaitGroup
+	copyErrs := make([]error, len(cmd.pipes))
+	for i, p := range cmd.pipes {
+		copying.Add(1)
+		go func(i int, p *pipe) {
+			defer copying.Done()
+			err := p.copy(local[i])
+			if p.input && errors.Is(err, syscall.EPIPE) {
+				// ffmpeg may stop reading an input before its end
+				err = nil
+			}
+			copyErrs[i] = err
+		}(i, p)
+	}
+
+	copied := make(chan struct{})
+	go func() {
+		copying.Wait()
+		close(copied)
+	}()
+
+	cmd.done = make(chan struct{})
+	go func() {
+		err := proc.Wait()
+		select {
+		case <-copied:
+			for _, copyErr := range copyErrs {
+				if err == nil {
+					err = copyErr
+				}
+			}
+		case <-ctx.Done():
+			// a reader blocking forever must not keep a stopped command
+			// from completing, so its copy is abandoned
+		}
+		cmd.err = err
+		close(cmd.done)
+	}()
+
+	cmd.watched = make(chan struct{})
+	go func() {
+		defer close(cmd.watched)
+		select {
+		case <-cmd.done:
+		case <-ctx.Done():
+			// both may be ready at once, and a command that already
+			// completed was not interrupted
+			select {
+			case <-cmd.done:
+				return
+			default:
+			}
+			cmd.interrupted = &InterruptError{
+				Err:       ctx.Err(),
+				Finalized: cmd.stop(),
+			}
+		}
+	}()
+
 	return nil
 }
+
+// Wait waits for the command to exit and for copying to and from its pipes
+// to complete. The command must have been started by Start.
+//
+// If ffmpeg exits unsuccessfully the returned error is an *Error, and if
+// it was stopped because its context was done an *InterruptError.
+func (cmd *Cmd) Wait() error {
+	cmd.mu.Lock()
+	switch {
+	case cmd.proc == nil:
+		cmd.mu.Unlock()
+		return ErrNotStarted
+	case cmd.waited:
+		cmd.mu.Unlock()
+		return ErrAlreadyWaited
+	}
+	cmd.waited = true
+	cmd.mu.Unlock()
+
+	<-cmd.watched
+	for _, c := range cmd.closeAfterWait {
+		c.Close()
+	}
+	cmd.log.Flush()
+
+	if cmd.interrupted != nil {
+		return cmd.interrupted
+	}
+	if exit, ok := cmd.err.(interface{ ExitCode() int }); ok {
+		return newError(cmd.err, exit.ExitCode(), cmd.stderr.Lines())
+	}
+	return cmd.err
+}
+
+// String returns the command line, quoted so it can be pasted into a POSIX
+// shell. It can be read back with Parse.
+func (cmd *Cmd) String() string {
+	return shellJoin(append([]string{cmd.path}, cmd.Args...))
+}
+
+// Pid returns the process id of the started command, or -1 if it has not
+// been started.
+func (cmd *Cmd) Pid() int {
+	cmd.mu.Lock()
+	defer cmd.mu.Unlock()
+
+	if cmd.proc == nil {
+		return -1
+	}
+	return cmd.proc.Pid()
+}
+
+// Signal sends a signal to the started command.
+func (cmd *Cmd) Signal(sig os.Signal) error {
+	cmd.mu.Lock()
+	defer cmd.mu.Unlock()
+
+	if cmd.proc == nil {
+		return ErrNotStarted
+	}
+	return cmd.proc.Signal(sig)
+}
+
+// Pause suspends the started command until Resume is called, for example
+// to give the processor to a more urgent job. A paused command that is
+// stopped because its context is done is resumed first.
+//
+// Pausing is not supported on all platforms, and only suspends the
+// process started by the Executor, which may be a wrapper around ffmpeg.
+func (cmd *Cmd) Pause() error {
+	if pauseSignal == nil {
+		return errPauseUnsupported
+	}
+	return cmd.Signal(pauseSignal)
+}
+
+// Resume continues a command suspended by Pause.
+func (cmd *Cmd) Resume() error {
+	if resumeSignal == nil {
+		return errPauseUnsupported
+	}
+	return cmd.Signal(resumeSignal)
+}
+
+// Progress returns a channel on which the progress of the encode is
+// reported while the command runs. The channel is closed once ffmpeg exits,
+// or if the command fails to start.
+//
+// Progress must be called before the command is started. Progress is read from
+// a dedicated pipe passed to ffmpeg with "-progress", so it never mixes with
+// the log output. Reports are never queued: a receiver that falls behind
+// only sees the most recent one.
+func (cmd *Cmd) Progress() <-chan Progress {
+	cmd.mu.Lock()
+	defer cmd.mu.Unlock()
+
+	if cmd.progress == nil && cmd.proc != nil {
+		// too late for ffmpeg to report to us
+		ch := make(chan Progress)
+		close(ch)
+		return ch
+	}
+	if cmd.progress == nil {
+		cmd.progress = make(chan Progress, 1)
+		url := cmd.addPipe(&pipe{
+			copy: func(f *os.File) error {
+				read := false
+				cmd.progressOnce.Do(func() {
+					read = true
+					readProgress(f, cmd.progress)
+				})
+				if !read {
+					// an earlier Start failed and closed the channel
+					io.Copy(ioutil.Discard, f)
+				}
+				return nil
+			},
+		})
+		cmd.Args = append([]string{"-progress", url}, cmd.Args...)
+	}
+	return cmd.progress
+}
+
+// pipe is a stream between the caller and ffmpeg, passed to ffmpeg as an
+// additional file descriptor.
+type pipe struct {
+	// input is set when ffmpeg reads from the pipe.
+	input bool
+	// copy transfers the data through the caller's end of the pipe while
+	// ffmpeg runs.
+	copy func(*os.File) error
+}
+
+// addPipe adds a pipe to the command, returning the url ffmpeg can use to
+// access it.
+func (cmd *Cmd) addPipe(p *pipe) string {
+	cmd.pipes = append(cmd.pipes, p)
+	// additional file descriptors are numbered from 3, after stdin, stdout
+	// and stderr
+	return fmt.Sprintf("pipe:%d", 2+len(cmd.pipes))
+}
+
+// readerPipe returns a pipe copying r into ffmpeg.
+func readerPipe(r io.Reader) *pipe {
+	return &pipe{
+		input: true,
+		copy: func(f *os.File) error {
+			defer f.Close()
+			_, err := io.Copy(f, r)
+			return err
+		},
+	}
+}
+
+// writerPipe returns a pipe copying the output of ffmpeg to w.
+func writerPipe(w io.Writer) *pipe {
+	return &pipe{
+		copy: func(f *os.File) error {
+			_, err := io.Copy(w, f)
+			if err != nil {
+				// keep draining so ffmpeg never blocks writing its output
+				io.Copy(ioutil.Discard, f)
+			}
+			return err
+		},
+	}
+}
+
+// stop asks ffmpeg to quit and waits for it to exit, killing it if it is
+// still running after the grace period. It reports whether ffmpeg exited
+// without being killed.
+func (cmd *Cmd) stop() bool {
+	if resumeSignal != nil {
+		cmd.proc.Signal(resumeSignal)
+	}
+
+	var err error
+	switch {
+	case cmd.stdin != nil:
+		_, err = io.WriteString(cmd.stdin, "q")
+	case interruptSignal != nil:
+		err = cmd.proc.Signal(interruptSignal)
+	default:
+		err = errInterruptUnsupported
+	}
+
+	if err == nil {
+		grace := cmd.GracePeriod
+		if grace <= 0 {
+			grace = DefaultGracePeriod
+		}
+
+		timer := time.NewTimer(grace)
+		defer timer.Stop()
+
+		select {
+		case <-cmd.done:
+			return true
+		case <-timer.C:
+		}
+	}
+
+	cmd.proc.Kill()
+	<-cmd.done
+	return false
+}
+
+// hasFlag reports whether flag is present in the command arguments.
+func (cmd *Cmd) hasFlag(flag string) bool {
+	for _, arg := range cmd.Args {
+		if arg == flag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package ffmpeg
+
+import "os"
+
+// Pausing a command is not supported on this platform, and neither is
+// interrupting it: os.Process.Signal can only kill it.
+var pauseSignal, resumeSignal, interruptSignal os.Signal
@@ -0,0 +1,400 @@
+package ffmpeg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/benhinchley/ffmpeg/ffmpegtest"
+)
+
+func TestMain(m *testing.M) {
+	ffmpegtest.Main()
+	os.Exit(m.Run())
+}
+
+func TestRunContextCancel(t *testing.T) {
+	tests := []struct {
+		Name      string
+		BaseFlags []string
+		Script    ffmpegtest.Script
+		Grace     time.Duration
+		Finalized bool
+	}{
+		{
+			Name:      "interrupted",
+			Script:    ffmpegtest.Script{Hang: true, ExitCode: 255},
+			Grace:     10 * time.Second,
+			Finalized: true,
+		},
+		{
+			Name:      "quit on stdin",
+			BaseFlags: []string{"-hide_banner"},
+			Script:    ffmpegtest.Script{Hang: true},
+			Grace:     10 * time.Second,
+			Finalized: true,
+		},
+		{
+			Name:      "killed",
+			Script:    ffmpegtest.Script{Hang: true, IgnoreInterrupt: true},
+			Grace:     200 * time.Millisecond,
+			Finalized: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			out := filepath.Join(t.TempDir(), "out.mp4")
+			test.Script.Files = map[string]string{out: "trailer"}
+			test.Script.Progress = []string{"frame=1", "progress=continue"}
+			stub := ffmpegtest.New(t, test.Script)
+			r := &Runner{Path: stub.Path, Env: stub.Env, BaseFlags: test.BaseFlags}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			cmd, err := r.CommandContext(ctx, nil, Input("in.mp4"), Output(out))
+			if err != nil {
+				t.Fatalf("unable to create command: %v", err)
+			}
+			cmd.GracePeriod = test.Grace
+
+			// the stub reports progress once it is ready to be interrupted
+			progress := cmd.Progress()
+			go func() {
+				<-progress
+				cancel()
+			}()
+
+			err = cmd.Run()
+			var ierr *InterruptError
+			if !errors.As(err, &ierr) {
+				t.Fatalf("Expected *InterruptError got %v", err)
+			}
+			if ierr.Finalized != test.Finalized {
+				t.Errorf("Expected finalized %v got %v", test.Finalized, ierr.Finalized)
+			}
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Expected %v got %v", context.Canceled, err)
+			}
+
+			_, err = os.Stat(out)
+			if written := err == nil; written != test.Finalized {
+				t.Errorf("Expected output written %v got %v", test.Finalized, written)
+			}
+		})
+	}
+}
+
+func TestRunContextCancelAfterExit(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		cmd, err := r.CommandContext(ctx, nil, Input("in.mp4"), Output("out.mp4"))
+		if err != nil {
+			t.Fatalf("unable to create command: %v", err)
+		}
+		if err := cmd.Start(); err != nil {
+			t.Fatalf("unable to start command: %v", err)
+		}
+
+		// cancelled once ffmpeg exited, but possibly before that is seen
+		<-cmd.done
+		cancel()
+		if err := cmd.Wait(); err != nil {
+			t.Errorf("%d: Expected command to complete got %v", i, err)
+		}
+	}
+}
+
+func TestRunError(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{
+		Stderr: []string{
+			"Input #0, mov,mp4,m4a,3gp,3g2,mj2, from 'in.mp4':",
+			"[mp4 @ 0x7fa] Could not find tag for codec vp6f in stream #0, codec not currently supported in container",
+			"Could not write header for output file #0 (incorrect codec parameters ?): Invalid argument",
+		},
+		ExitCode: 1,
+	})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	cmd, err := r.Command(nil, Input("in.mp4"), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	err = cmd.Run()
+	var ferr *Error
+	if !errors.As(err, &ferr) {
+		t.Fatalf("Expected *Error got %v", err)
+	}
+	if !errors.Is(err, ErrUnsupportedCodec) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedCodec, err)
+	}
+	if ferr.ExitCode != 1 {
+		t.Errorf("Expected exit code 1 got %d", ferr.ExitCode)
+	}
+	if len(ferr.Log) != 3 {
+		t.Errorf("Expected 3 log lines got %d", len(ferr.Log))
+	}
+
+	calls := stub.Calls()
+	if len(calls) != 1 || calls[0][len(calls[0])-1] != "out.mp4" {
+		t.Errorf("Expected a single call writing out.mp4 got %v", calls)
+	}
+}
+
+func TestRunProgress(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{
+		Progress: []string{
+			"frame=10", "out_time_us=400000", "progress=continue",
+			"frame=25", "out_time_us=1000000", "progress=end",
+		},
+	})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	cmd, err := r.Command(nil, Input("in.mp4"), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	progress := cmd.Progress()
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("unable to run command: %v", err)
+	}
+
+	var last Progress
+	for p := range progress {
+		last = p
+	}
+	expected := Progress{Frame: 25, OutTime: time.Second, Done: true}
+	if last != expected {
+		t.Errorf("Expected %+v got %+v", expected, last)
+	}
+}
+
+func TestMissingBinary(t *testing.T) {
+	r := &Runner{Path: filepath.Join(t.TempDir(), "ffmpeg")}
+	if err := ioutil.WriteFile(r.Path, nil, 0644); err != nil {
+		t.Fatalf("unable to write file: %v", err)
+	}
+
+	cmd, err := r.Command(nil, Input("in.mp4"), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	var ferr *Error
+	if err := cmd.Run(); err == nil || errors.As(err, &ferr) {
+		t.Errorf("Expected a start error got %v", err)
+	}
+}
+
+func TestProgressFailedStart(t *testing.T) {
+	r := &Runner{Path: filepath.Join(t.TempDir(), "ffmpeg")}
+	cmd, err := r.Command(nil, Input("in.mp4"), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	progress := cmd.Progress()
+	if err := cmd.Start(); err == nil {
+		t.Fatalf("Expected a start error")
+	}
+	select {
+	case _, ok := <-progress:
+		if ok {
+			t.Errorf("Expected no progress report")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Progress channel not closed after failed start")
+	}
+}
+
+func TestRunPipes(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{
+		Files: map[string]string{"pipe:5": "first", "pipe:6": "second"},
+	})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	in1, in2 := strings.NewReader("input one"), strings.NewReader("input two")
+	var out1, out2 bytes.Buffer
+
+	cmd, err := r.Command(nil,
+		InputReader(in1),
+		Input("in.mp4"),
+		InputReader(in2),
+		OutputWriter(&out1, WithFormat(FileFormatMatroska)),
+		OutputWriter(&out2, WithFormat(FileFormatMpegts)))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	progress := cmd.Progress()
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("unable to run command: %v", err)
+	}
+	for range progress {
+	}
+
+	expected := "-progress pipe:7 -hide_banner -nostdin -xerror -i pipe:3 -i in.mp4 -i pipe:4 -f matroska pipe:5 -f mpegts pipe:6"
+	if got := strings.Join(stub.Calls()[0], " "); got != expected {
+		t.Errorf("Expected %s got %s", expected, got)
+	}
+	if in1.Len() != 0 || in2.Len() != 0 {
+		t.Errorf("Expected inputs to be read")
+	}
+	if out1.String() != "first" || out2.String() != "second" {
+		t.Errorf("Expected outputs first, second got %s, %s", out1.String(), out2.String())
+	}
+}
+
+func TestRunInputReaderError(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	readErr := errors.New("read failed")
+	cmd, err := r.Command(nil, InputReader(iotest.ErrReader(readErr)), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+	if err := cmd.Run(); err != readErr {
+		t.Errorf("Expected %v got %v", readErr, err)
+	}
+}
+
+// blockingReader blocks reading until release is closed.
+type blockingReader struct {
+	release chan struct{}
+}
+
+func (r blockingReader) Read(p []byte) (int, error) {
+	<-r.release
+	return 0, io.EOF
+}
+
+func TestRunContextCancelBlockedReader(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{Hang: true})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	in := blockingReader{release: make(chan struct{})}
+	defer close(in.release)
+
+	// the stub reads its inputs to their end first, so it never gets to
+	// report progress
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	cmd, err := r.CommandContext(ctx, nil, InputReader(in), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+	cmd.GracePeriod = 100 * time.Millisecond
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("unable to start command: %v", err)
+	}
+
+	waited := make(chan error, 1)
+	go func() { waited <- cmd.Wait() }()
+	select {
+	case err := <-waited:
+		var ierr *InterruptError
+		if !errors.As(err, &ierr) {
+			t.Errorf("Expected *InterruptError got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected Wait to return once the command is stopped")
+	}
+}
+
+func TestCommandReusesStreamedFiles(t *testing.T) {
+	var out bytes.Buffer
+	in := InputReader(strings.NewReader("input"))
+	w := OutputWriter(&out, WithFormat(FileFormatMatroska))
+
+	first, err := Command(nil, in, w)
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+	second, err := Command(nil, Input("in.mp4"), in, w)
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	if got, expected := strings.Join(first.Args, " "), "-hide_banner -nostdin -xerror -i pipe:3 -f matroska pipe:4"; got != expected {
+		t.Errorf("Expected %s got %s", expected, got)
+	}
+	if got, expected := strings.Join(second.Args, " "), "-hide_banner -nostdin -xerror -i in.mp4 -i pipe:3 -f matroska pipe:4"; got != expected {
+		t.Errorf("Expected %s got %s", expected, got)
+	}
+	if in.path != "" || w.path != "" {
+		t.Errorf("Expected files to be left as they are got paths %q, %q", in.path, w.path)
+	}
+	if got, expected := strings.Join(append(in.Flags(), w.Flags()...), " "), "-i pipe: -f matroska pipe:"; got != expected {
+		t.Errorf("Expected %s got %s", expected, got)
+	}
+}
+
+func TestOutputWriterFormat(t *testing.T) {
+	var out bytes.Buffer
+	if _, err := Command(nil, Input("in.mp4"), OutputWriter(&out)); err == nil {
+		t.Errorf("Expected an error for an output without format")
+	}
+}
+
+func TestProcessControl(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{Hang: true, ExitCode: 255})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	cmd, err := r.Command(nil, Input("in.mp4"), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	if err := cmd.Wait(); err != ErrNotStarted {
+		t.Errorf("Expected %v got %v", ErrNotStarted, err)
+	}
+	if err := cmd.Signal(os.Interrupt); err != ErrNotStarted {
+		t.Errorf("Expected %v got %v", ErrNotStarted, err)
+	}
+	if pid := cmd.Pid(); pid != -1 {
+		t.Errorf("Expected pid -1 got %d", pid)
+	}
+
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("unable to start command: %v", err)
+	}
+	if err := cmd.Start(); err != ErrAlreadyStarted {
+		t.Errorf("Expected %v got %v", ErrAlreadyStarted, err)
+	}
+	if pid := cmd.Pid(); pid <= 0 {
+		t.Errorf("Expected a pid got %d", pid)
+	}
+
+	if err := cmd.Pause(); err != nil {
+		t.Errorf("unable to pause command: %v", err)
+	}
+	if err := cmd.Resume(); err != nil {
+		t.Errorf("unable to resume command: %v", err)
+	}
+	if err := cmd.Signal(os.Kill); err != nil {
+		t.Errorf("unable to signal command: %v", err)
+	}
+
+	var ferr *Error
+	if err := cmd.Wait(); !errors.As(err, &ferr) {
+		t.Errorf("Expected *Error got %v", err)
+	}
+	if err := cmd.Wait(); err != ErrAlreadyWaited {
+		t.Errorf("Expected %v got %v", ErrAlreadyWaited, err)
+	}
+}
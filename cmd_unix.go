@@ -0,0 +1,16 @@
+//go:build unix
+
+package ffmpeg
+
+import (
+	"os"
+	"syscall"
+)
+
+// Signals used to pause and resume a command, and to ask it to stop when
+// its stdin is not available.
+var (
+	pauseSignal     os.Signal = syscall.SIGSTOP
+	resumeSignal    os.Signal = syscall.SIGCONT
+	interruptSignal os.Signal = os.Interrupt
+)
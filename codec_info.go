@@ -0,0 +1,178 @@
+package ffmpeg
+
+// codecProps are the capabilities of a codec, as listed by "ffmpeg -codecs".
+type codecProps int
+
+const (
+	codecDecode codecProps = 1 << iota
+	codecEncode
+	codecIntraOnly
+	codecLossy
+	codecLossless
+)
+
+// noCodec stands for the absence of a codec, such as the codec of
+// EncoderCopy.
+const noCodec Codec = -1
+
+type codecInfo struct {
+	mediaType StreamType
+	props     codecProps
+}
+
+func (typ Codec) info() codecInfo {
+	if typ < 0 || int(typ) >= len(codecInfos) {
+		return codecInfo{mediaType: StreamTypeAll}
+	}
+	return codecInfos[typ]
+}
+
+// MediaType returns the type of the streams the codec is used for.
+func (typ Codec) MediaType() StreamType {
+	return typ.info().mediaType
+}
+
+// CanDecode reports whether ffmpeg has a decoder for the codec.
+func (typ Codec) CanDecode() bool {
+	return typ.info().props&codecDecode != 0
+}
+
+// CanEncode reports whether ffmpeg has an encoder for the codec.
+func (typ Codec) CanEncode() bool {
+	return typ.info().props&codecEncode != 0
+}
+
+// IntraOnly reports whether the codec only uses intra frames.
+func (typ Codec) IntraOnly() bool {
+	return typ.info().props&codecIntraOnly != 0
+}
+
+// Lossy reports whether the codec supports lossy compression.
+func (typ Codec) Lossy() bool {
+	return typ.info().props&codecLossy != 0
+}
+
+// Lossless reports whether the codec supports lossless compression.
+//
+// A codec can support both, such as h264.
+func (typ Codec) Lossless() bool {
+	return typ.info().props&codecLossless != 0
+}
+
+// Encoders returns the encoders of the codec, in the order ffmpeg lists
+// them, which is the order it prefers them in.
+func (typ Codec) Encoders() []Encoder {
+	var encoders []Encoder
+	for enc, info := range encoderInfos {
+		if info.codec == typ && Encoder(enc) != EncoderCopy {
+			encoders = append(encoders, Encoder(enc))
+		}
+	}
+	return encoders
+}
+
+// Decoders returns the decoders of the codec, in the order ffmpeg lists
+// them, which is the order it prefers them in.
+func (typ Codec) Decoders() []Decoder {
+	var decoders []Decoder
+	for dec, info := range decoderInfos {
+		if info.codec == typ {
+			decoders = append(decoders, Decoder(dec))
+		}
+	}
+	return decoders
+}
+
+// coderProps are the capabilities of an encoder or decoder, as listed by
+// "ffmpeg -encoders" and "ffmpeg -decoders".
+type coderProps int
+
+const (
+	coderFrameThreads coderProps = 1 << iota
+	coderSliceThreads
+	coderExperimental
+)
+
+type coderInfo struct {
+	codec        Codec
+	props        coderProps
+	pixelFormats []PixelFormat
+}
+
+func (typ Encoder) info() coderInfo {
+	if typ == EncoderCopy || typ < 0 || int(typ) >= len(encoderInfos) {
+		return coderInfo{codec: noCodec}
+	}
+	return encoderInfos[typ]
+}
+
+// Codec returns the codec the encoder encodes to. EncoderCopy has none.
+func (typ Encoder) Codec() Codec {
+	return typ.info().codec
+}
+
+// MediaType returns the type of the streams the encoder is used for.
+// EncoderCopy is used for all types, StreamTypeAll.
+func (typ Encoder) MediaType() StreamType {
+	return typ.Codec().MediaType()
+}
+
+// FrameThreads reports whether the encoder supports frame-level
+// multithreading.
+func (typ Encoder) FrameThreads() bool {
+	return typ.info().props&coderFrameThreads != 0
+}
+
+// SliceThreads reports whether the encoder supports slice-level
+// multithreading.
+func (typ Encoder) SliceThreads() bool {
+	return typ.info().props&coderSliceThreads != 0
+}
+
+// Experimental reports whether the encoder is experimental, which ffmpeg
+// only uses with "-strict experimental".
+func (typ Encoder) Experimental() bool {
+	return typ.info().props&coderExperimental != 0
+}
+
+// PixelFormats returns the pixel formats the encoder supports, in the
+// order ffmpeg lists them, or nil if it does not list them, such as for
+// audio encoders and rawvideo which accepts any.
+func (typ Encoder) PixelFormats() []PixelFormat {
+	return typ.info().pixelFormats
+}
+
+func (typ Decoder) info() coderInfo {
+	if typ < 0 || int(typ) >= len(decoderInfos) {
+		return coderInfo{codec: noCodec}
+	}
+	return decoderInfos[typ]
+}
+
+// Codec returns the codec the decoder decodes.
+func (typ Decoder) Codec() Codec {
+	return typ.info().codec
+}
+
+// MediaType returns the type of the streams the decoder is used for.
+func (typ Decoder) MediaType() StreamType {
+	return typ.Codec().MediaType()
+}
+
+// FrameThreads reports whether the decoder supports frame-level
+// multithreading.
+func (typ Decoder) FrameThreads() bool {
+	return typ.info().props&coderFrameThreads != 0
+}
+
+// SliceThreads reports whether the decoder supports slice-level
+// multithreading.
+func (typ Decoder) SliceThreads() bool {
+	return typ.info().props&coderSliceThreads != 0
+}
+
+// Experimental reports whether the decoder is experimental, which ffmpeg
+// only uses with "-strict experimental".
+func (typ Decoder) Experimental() bool {
+	return typ.info().props&coderExperimental != 0
+}
@@ -0,0 +1,87 @@
+package ffmpeg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCodecInfo(t *testing.T) {
+	tests := []struct {
+		Codec     Codec
+		MediaType StreamType
+		Decode    bool
+		Encode    bool
+		IntraOnly bool
+		Lossy     bool
+		Lossless  bool
+		Encoders  []Encoder
+		Decoders  []Decoder
+	}{
+		{CodecH264, StreamTypeVideo, true, true, false, true, true,
+			[]Encoder{EncoderLibx264, EncoderLibx264Rgb, EncoderH264Videotoolbox}, []Decoder{DecoderH264}},
+		{CodecPng, StreamTypeVideo, true, true, true, false, true,
+			[]Encoder{EncoderPng}, []Decoder{DecoderPng}},
+		{CodecAv1, StreamTypeVideo, false, false, false, true, false, nil, nil},
+		{CodecAac, StreamTypeAudio, true, true, false, true, false,
+			[]Encoder{EncoderAac, EncoderAacAt}, []Decoder{DecoderAac, DecoderAacFixed, DecoderAacAt}},
+		{CodecSubrip, StreamTypeSubtitle, true, true, false, false, false,
+			[]Encoder{EncoderSrt, EncoderSubrip}, []Decoder{DecoderSrt, DecoderSubrip}},
+		{CodecBinData, StreamTypeData, false, false, false, false, false, nil, nil},
+		{Codec(-1), StreamTypeAll, false, false, false, false, false, nil, nil},
+	}
+
+	for _, test := range tests {
+		if got := test.Codec.MediaType(); got != test.MediaType {
+			t.Errorf("%s: Expected media type %d got %d", test.Codec, test.MediaType, got)
+		}
+		got := []bool{test.Codec.CanDecode(), test.Codec.CanEncode(), test.Codec.IntraOnly(), test.Codec.Lossy(), test.Codec.Lossless()}
+		expected := []bool{test.Decode, test.Encode, test.IntraOnly, test.Lossy, test.Lossless}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: Expected %v got %v", test.Codec, expected, got)
+		}
+		if got := test.Codec.Encoders(); !reflect.DeepEqual(got, test.Encoders) {
+			t.Errorf("%s: Expected encoders %v got %v", test.Codec, test.Encoders, got)
+		}
+		if got := test.Codec.Decoders(); !reflect.DeepEqual(got, test.Decoders) {
+			t.Errorf("%s: Expected decoders %v got %v", test.Codec, test.Decoders, got)
+		}
+	}
+}
+
+func TestCoderInfo(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Codec        Codec
+		MediaType    StreamType
+		FrameThreads bool
+		SliceThreads bool
+		Experimental bool
+		Coder        interface {
+			Codec() Codec
+			MediaType() StreamType
+			FrameThreads() bool
+			SliceThreads() bool
+			Experimental() bool
+		}
+	}{
+		{"libx264", CodecH264, StreamTypeVideo, false, false, false, EncoderLibx264},
+		{"vorbis", CodecVorbis, StreamTypeAudio, false, false, true, EncoderVorbis},
+		{"copy", Codec(-1), StreamTypeAll, false, false, false, EncoderCopy},
+		{"h264", CodecH264, StreamTypeVideo, true, true, false, DecoderH264},
+		{"aac_at", CodecAac, StreamTypeAudio, false, false, false, DecoderAacAt},
+	}
+
+	for _, test := range tests {
+		if got := test.Coder.Codec(); got != test.Codec {
+			t.Errorf("%s: Expected codec %s got %s", test.Name, test.Codec, got)
+		}
+		if got := test.Coder.MediaType(); got != test.MediaType {
+			t.Errorf("%s: Expected media type %d got %d", test.Name, test.MediaType, got)
+		}
+		got := []bool{test.Coder.FrameThreads(), test.Coder.SliceThreads(), test.Coder.Experimental()}
+		expected := []bool{test.FrameThreads, test.SliceThreads, test.Experimental}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: Expected %v got %v", test.Name, expected, got)
+		}
+	}
+}
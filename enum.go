@@ -0,0 +1,77 @@
+package ffmpeg
+
+import (
+	"fmt"
+	"strings"
+)
+
+// unknownName returns the error of parsing name as a kind of constant,
+// such as "codec", when it is none of names. It suggests the nearest of
+// them, which catches typos and differences of case.
+func unknownName(kind, name string, names []string) error {
+	if nearest := nearestName(name, names); nearest != "" {
+		return fmt.Errorf("unknown %s %q, did you mean %q?", kind, name, nearest)
+	}
+	return fmt.Errorf("unknown %s %q", kind, name)
+}
+
+// invalidValue returns the error of marshalling a value that is none of
+// the constants of a kind.
+func invalidValue(kind string, v int) error {
+	return fmt.Errorf("invalid %s %d", kind, v)
+}
+
+// nearestName returns the name closest to name by edit distance, ignoring
+// case, or "" if none is close enough to be a likely typo. Of names as
+// close, the one sharing the longest prefix with name is preferred.
+func nearestName(name string, names []string) string {
+	name = strings.ToLower(name)
+
+	nearest, dist, prefix := "", 0, 0
+	for _, n := range names {
+		l := strings.ToLower(n)
+		d := editDistance(name, l)
+		if 2*d > max(len(name), len(l)) {
+			continue
+		}
+		p := commonPrefix(name, l)
+		if nearest == "" || d < dist || (d == dist && p > prefix) {
+			nearest, dist, prefix = n, d, p
+		}
+	}
+	return nearest
+}
+
+// editDistance returns the number of characters to insert, delete,
+// substitute or swap with the next to turn a into b.
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
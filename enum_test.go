@@ -0,0 +1,117 @@
+package ffmpeg
+
+import (
+	"encoding/json"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseEnums(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Parse    func(string) (interface{}, error)
+		Expected interface{}
+		Err      string
+	}{
+		{"h264", func(s string) (interface{}, error) { return ParseCodec(s) }, CodecH264, ""},
+		{"h246", func(s string) (interface{}, error) { return ParseCodec(s) }, nil, `unknown codec "h246", did you mean "h264"?`},
+		{"H264", func(s string) (interface{}, error) { return ParseCodec(s) }, nil, `unknown codec "H264", did you mean "h264"?`},
+		{"matroska", func(s string) (interface{}, error) { return ParseFileFormat(s) }, FileFormatMatroska, ""},
+		{"matroksa", func(s string) (interface{}, error) { return ParseFileFormat(s) }, nil, `unknown file format "matroksa", did you mean "matroska"?`},
+		{"yuv420p10le", func(s string) (interface{}, error) { return ParsePixelFormat(s) }, PixelFormatYuv420P10Le, ""},
+		{"yuv420p10", func(s string) (interface{}, error) { return ParsePixelFormat(s) }, nil, `did you mean "yuv420p10`},
+		{"copy", func(s string) (interface{}, error) { return ParseEncoder(s) }, EncoderCopy, ""},
+		{"aac_fixed", func(s string) (interface{}, error) { return ParseDecoder(s) }, DecoderAacFixed, ""},
+		{"zzzzzzzzzz", func(s string) (interface{}, error) { return ParseDecoder(s) }, nil, `unknown decoder "zzzzzzzzzz"`},
+		{"warning", func(s string) (interface{}, error) { return ParseLogLevel(s) }, LogLevelWarning, ""},
+		{"warn", func(s string) (interface{}, error) { return ParseLogLevel(s) }, nil, `unknown log level "warn", did you mean "warning"?`},
+		{"a", func(s string) (interface{}, error) { return ParseStreamType(s) }, StreamTypeAudio, ""},
+		{"", func(s string) (interface{}, error) { return ParseStreamType(s) }, StreamTypeAll, ""},
+		{"video", func(s string) (interface{}, error) { return ParseStreamType(s) }, nil, `unknown stream type "video"`},
+	}
+
+	for _, test := range tests {
+		got, err := test.Parse(test.Name)
+		if test.Err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.Err) {
+				t.Errorf("%s: Expected error %q got %v", test.Name, test.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unable to parse: %v", test.Name, err)
+		} else if got != test.Expected {
+			t.Errorf("%s: Expected %v got %v", test.Name, test.Expected, got)
+		}
+	}
+}
+
+func TestAllEnums(t *testing.T) {
+	codecs := AllCodecs()
+	if len(codecs) != len(codecInfos) || codecs[0] != Codec(0) || codecs[len(codecs)-1].String() == "" {
+		t.Errorf("Expected %d codecs got %d", len(codecInfos), len(codecs))
+	}
+	if formats := AllFileFormats(); len(formats) != len(fileFormatInfos) {
+		t.Errorf("Expected %d file formats got %d", len(fileFormatInfos), len(formats))
+	}
+	if pfs := AllPixelFormats(); len(pfs) != len(pixelFormatInfos) {
+		t.Errorf("Expected %d pixel formats got %d", len(pixelFormatInfos), len(pfs))
+	}
+	if levels := AllLogLevels(); len(levels) != 9 || levels[0] != LogLevelQuiet || levels[8] != LogLevelTrace {
+		t.Errorf("Expected quiet to trace got %v", levels)
+	}
+	if types := AllStreamTypes(); len(types) != 7 {
+		t.Errorf("Expected 7 stream types got %v", types)
+	}
+}
+
+func TestEnumsText(t *testing.T) {
+	type spec struct {
+		Codec       Codec       `json:"codec"`
+		Format      FileFormat  `json:"format"`
+		PixelFormat PixelFormat `json:"pix_fmt"`
+		LogLevel    LogLevel    `json:"loglevel"`
+		Stream      StreamType  `json:"stream"`
+	}
+
+	in := `{"codec":"hevc","format":"mp4","pix_fmt":"yuv420p10le","loglevel":"error","stream":"v"}`
+	var s spec
+	if err := json.Unmarshal([]byte(in), &s); err != nil {
+		t.Fatalf("unable to unmarshal: %v", err)
+	}
+	expected := spec{CodecHevc, FileFormatMp4, PixelFormatYuv420P10Le, LogLevelError, StreamTypeVideo}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected %+v got %+v", expected, s)
+	}
+	out, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("unable to marshal: %v", err)
+	}
+	if string(out) != in {
+		t.Errorf("Expected %s got %s", in, out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"codec":"hvec"}`), &s); err == nil || !strings.Contains(err.Error(), `did you mean "hevc"?`) {
+		t.Errorf("Expected error suggesting hevc got %v", err)
+	}
+	if _, err := json.Marshal(spec{Codec: Codec(-1)}); err == nil {
+		t.Errorf("Expected error marshalling invalid codec")
+	}
+}
+
+func TestEnumsFlag(t *testing.T) {
+	codec, pf, level := CodecH264, PixelFormatYuv420P, LogLevelInfo
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&codec, "codec", "")
+	fs.Var(&pf, "pix_fmt", "")
+	fs.Var(&level, "loglevel", "")
+
+	if err := fs.Parse([]string{"-codec", "vp9", "-pix_fmt", "nv12", "-loglevel", "debug"}); err != nil {
+		t.Fatalf("unable to parse flags: %v", err)
+	}
+	if codec != CodecVp9 || pf != PixelFormatNv12 || level != LogLevelDebug {
+		t.Errorf("Expected vp9 nv12 debug got %s %s %s", codec, pf, level)
+	}
+}
@@ -0,0 +1,170 @@
+package ffmpeg
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Errors that an *Error can be matched against using errors.Is.
+var (
+	ErrInputNotFound    = errors.New("input not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrUnknownEncoder   = errors.New("unknown encoder")
+	ErrUnknownDecoder   = errors.New("unknown decoder")
+	ErrUnknownFormat    = errors.New("unknown format")
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrUnsupportedCodec = errors.New("codec not supported by container")
+	ErrOutputExists     = errors.New("output already exists")
+	ErrNoSpace          = errors.New("no space left on device")
+	ErrTooManyPackets   = errors.New("too many packets buffered for output stream")
+)
+
+// ErrorKind classifies why ffmpeg failed.
+type ErrorKind int
+
+// Error kind definitions
+const (
+	ErrorKindUnknown          ErrorKind = iota // The failure could not be classified
+	ErrorKindInputNotFound                     // A file could not be found
+	ErrorKindPermissionDenied                  // A file could not be opened due to its permissions
+	ErrorKindUnknownEncoder                    // The requested encoder does not exist
+	ErrorKindUnknownDecoder                    // The requested decoder does not exist
+	ErrorKindUnknownFormat                     // The requested or guessed file format does not exist
+	ErrorKindInvalidArgument                   // An option or its value was rejected
+	ErrorKindUnsupportedCodec                  // The output container can not hold a stream's codec
+	ErrorKindOutputExists                      // An output file exists and overwriting is disabled
+	ErrorKindNoSpace                           // The disk holding an output is full
+	ErrorKindTooManyPackets                    // The muxing queue of an output stream overflowed
+)
+
+// errorKindsByPriority lists the kinds in the order they are matched, so
+// that a specific cause wins over the generic errors ffmpeg logs after it.
+var errorKindsByPriority = []ErrorKind{
+	ErrorKindOutputExists,
+	ErrorKindNoSpace,
+	ErrorKindTooManyPackets,
+	ErrorKindUnknownEncoder,
+	ErrorKindUnknownDecoder,
+	ErrorKindUnknownFormat,
+	ErrorKindUnsupportedCodec,
+	ErrorKindPermissionDenied,
+	ErrorKindInputNotFound,
+	ErrorKindInvalidArgument,
+}
+
+var errorKindPatterns = map[ErrorKind]*regexp.Regexp{
+	ErrorKindInputNotFound:    regexp.MustCompile(`No such file or directory`),
+	ErrorKindPermissionDenied: regexp.MustCompile(`Permission denied`),
+	ErrorKindUnknownEncoder:   regexp.MustCompile(`Unknown encoder '|Encoder \(codec .*\) not found`),
+	ErrorKindUnknownDecoder:   regexp.MustCompile(`Unknown decoder '|Decoder \(codec .*\) not found`),
+	ErrorKindUnknownFormat:    regexp.MustCompile(`Unknown input format: |Requested output format '.*' is not a suitable output format|Unable to find a suitable output format for `),
+	ErrorKindInvalidArgument:  regexp.MustCompile(`Invalid argument|Unrecognized option '|Error parsing option|Option .* not found`),
+	ErrorKindUnsupportedCodec: regexp.MustCompile(`codec not currently supported in container|Could not find tag for codec`),
+	ErrorKindOutputExists:     regexp.MustCompile(`already exists\. Exiting\.|Not overwriting - exiting`),
+	ErrorKindNoSpace:          regexp.MustCompile(`No space left on device`),
+	ErrorKindTooManyPackets:   regexp.MustCompile(`Too many packets buffered for output stream`),
+}
+
+func (k ErrorKind) String() string {
+	if err := k.sentinel(); err != nil {
+		return err.Error()
+	}
+	return "unknown error"
+}
+
+func (k ErrorKind) sentinel() error {
+	switch k {
+	case ErrorKindInputNotFound:
+		return ErrInputNotFound
+	case ErrorKindPermissionDenied:
+		return ErrPermissionDenied
+	case ErrorKindUnknownEncoder:
+		return ErrUnknownEncoder
+	case ErrorKindUnknownDecoder:
+		return ErrUnknownDecoder
+	case ErrorKindUnknownFormat:
+		return ErrUnknownFormat
+	case ErrorKindInvalidArgument:
+		return ErrInvalidArgument
+	case ErrorKindUnsupportedCodec:
+		return ErrUnsupportedCodec
+	case ErrorKindOutputExists:
+		return ErrOutputExists
+	case ErrorKindNoSpace:
+		return ErrNoSpace
+	case ErrorKindTooManyPackets:
+		return ErrTooManyPackets
+	default:
+		return nil
+	}
+}
+
+// Error is returned when ffmpeg exits unsuccessfully.
+//
+// Its Kind can be tested with errors.Is against the exported Err values,
+// for example errors.Is(err, ErrInputNotFound).
+type Error struct {
+	Kind     ErrorKind // Classification of the failure
+	ExitCode int       // Exit code of the ffmpeg process
+	Message  string    // Log line the failure was classified from, or the last one
+	Log      []string  // The last lines ffmpeg logged before exiting
+	Err      error     // The underlying error returned when waiting for ffmpeg
+}
+
+func newError(err error, code int, log []string) *Error {
+	e := &Error{
+		Kind:     ErrorKindUnknown,
+		ExitCode: code,
+		Log:      log,
+		Err:      err,
+	}
+	if len(log) > 0 {
+		e.Message = log[len(log)-1]
+	}
+
+	for _, kind := range errorKindsByPriority {
+		for _, line := range log {
+			if errorKindPatterns[kind].MatchString(line) {
+				e.Kind, e.Message = kind, line
+				return e
+			}
+		}
+	}
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("ffmpeg: %s: exit status %d", e.Kind, e.ExitCode)
+	}
+	return fmt.Sprintf("ffmpeg: %s: %s", e.Kind, e.Message)
+}
+
+// Is reports whether target is the Err value matching the error's Kind.
+func (e *Error) Is(target error) bool {
+	return target != nil && target == e.Kind.sentinel()
+}
+
+// Unwrap returns the underlying error returned when waiting for ffmpeg.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// logTail keeps the last log lines it is given.
+type logTail struct {
+	max   int
+	lines []string
+}
+
+func (l *logTail) add(line string) {
+	if len(l.lines) == l.max {
+		l.lines = l.lines[1:]
+	}
+	l.lines = append(l.lines, line)
+}
+
+// Lines returns the last lines given.
+func (l *logTail) Lines() []string {
+	return append([]string(nil), l.lines...)
+}
@@ -0,0 +1,54 @@
+package ffmpeg
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestNewError(t *testing.T) {
+	tests := []struct {
+		Log      []string
+		Expected error
+	}{
+		{Log: []string{"missing.mp4: No such file or directory"}, Expected: ErrInputNotFound},
+		{Log: []string{"out.mp4: Permission denied"}, Expected: ErrPermissionDenied},
+		{Log: []string{"Unknown encoder 'libfoo'"}, Expected: ErrUnknownEncoder},
+		{Log: []string{"Unknown decoder 'foo'"}, Expected: ErrUnknownDecoder},
+		{Log: []string{"Unknown input format: 'foo'"}, Expected: ErrUnknownFormat},
+		{Log: []string{"[NULL @ 0x7f8] Unable to find a suitable output format for 'out.foo'", "out.foo: Invalid argument"}, Expected: ErrUnknownFormat},
+		{Log: []string{"Unrecognized option 'foo'.", "Error splitting the argument list: Option not found"}, Expected: ErrInvalidArgument},
+		{Log: []string{"[mp4 @ 0x7f8] Could not find tag for codec vp6f in stream #0, codec not currently supported in container", "Could not write header for output file #0 (incorrect codec parameters ?): Invalid argument"}, Expected: ErrUnsupportedCodec},
+		{Log: []string{"File 'out.mp4' already exists. Exiting."}, Expected: ErrOutputExists},
+		{Log: []string{"av_interleaved_write_frame(): No space left on device"}, Expected: ErrNoSpace},
+		{Log: []string{"Too many packets buffered for output stream 0:1."}, Expected: ErrTooManyPackets},
+		{Log: []string{"Conversion failed!"}, Expected: nil},
+	}
+
+	for _, test := range tests {
+		err := newError(errors.New("exit status 1"), 1, test.Log)
+		if test.Expected == nil {
+			if err.Kind != ErrorKindUnknown {
+				t.Errorf("Expected %s got %s", ErrorKindUnknown, err.Kind)
+			}
+			continue
+		}
+		if !errors.Is(fmt.Errorf("wrapped: %w", err), test.Expected) {
+			t.Errorf("Expected %v got %v", test.Expected, err)
+		}
+	}
+}
+
+func TestLogTail(t *testing.T) {
+	l := &logTail{max: 2}
+	w := &lineWriter{fn: l.add}
+	fmt.Fprint(w, "first\nsecond\r\nframe=1\rframe=2\r")
+	fmt.Fprint(w, "last")
+	w.Flush()
+
+	expected := "frame=2 last"
+	if got := strings.Join(l.Lines(), " "); got != expected {
+		t.Errorf("Expected %s got %s", expected, got)
+	}
+}
@@ -0,0 +1,115 @@
+package ffmpeg
+
+import (
+	"io"
+	"os"
+	"os/exec"
+)
+
+// Executor starts the processes a Cmd runs.
+//
+// Implementations can run ffmpeg through a wrapper, on another machine or
+// not at all, without changing how commands are built.
+type Executor interface {
+	// Start starts the process described by inv and returns a handle on it.
+	Start(inv *Invocation) (Process, error)
+}
+
+// Invocation describes an ffmpeg process to be started by an Executor.
+type Invocation struct {
+	Path string   // Path of the ffmpeg binary
+	Args []string // Arguments, not including the binary itself
+	Env  []string // Environment in the form "key=value"
+	Dir  string   // Working directory, the current one if empty
+
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// ExtraFiles are additional open files passed to the process. Entry i
+	// becomes file descriptor 3+i.
+	ExtraFiles []*os.File
+}
+
+// Process is a handle on a process started by an Executor.
+type Process interface {
+	// Pid returns the process id, or -1 if it has none.
+	Pid() int
+
+	// Signal sends a signal to the process.
+	Signal(sig os.Signal) error
+
+	// Kill causes the process to exit immediately.
+	Kill() error
+
+	// Wait waits for the process to exit and for copying to and from its
+	// stdio to complete. An unsuccessful exit is reported with an error
+	// implementing ExitCode() int, such as *exec.ExitError.
+	Wait() error
+}
+
+// LocalExecutor runs processes on the local machine.
+type LocalExecutor struct{}
+
+// Start implements Executor
+func (LocalExecutor) Start(inv *Invocation) (Process, error) {
+	cmd := exec.Command(inv.Path, inv.Args...)
+	cmd.Env = inv.Env
+	cmd.Dir = inv.Dir
+	cmd.Stdin = inv.Stdin
+	cmd.Stdout = inv.Stdout
+	cmd.Stderr = inv.Stderr
+	cmd.ExtraFiles = inv.ExtraFiles
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &localProcess{cmd: cmd}, nil
+}
+
+type localProcess struct {
+	cmd *exec.Cmd
+}
+
+func (p *localProcess) Pid() int {
+	return p.cmd.Process.Pid
+}
+
+func (p *localProcess) Signal(sig os.Signal) error {
+	return p.cmd.Process.Signal(sig)
+}
+
+func (p *localProcess) Kill() error {
+	return p.cmd.Process.Kill()
+}
+
+func (p *localProcess) Wait() error {
+	return p.cmd.Wait()
+}
+
+// WrapExecutor returns an Executor that starts ffmpeg through a wrapper
+// command using e, for example:
+//
+//	WrapExecutor(LocalExecutor{}, "nice", "-n", "10")
+//
+// The wrapper must run ffmpeg with the remaining arguments, environment
+// and stdio it was given, and should forward signals to it.
+func WrapExecutor(e Executor, wrapper ...string) Executor {
+	return &wrapExecutor{exec: e, wrapper: wrapper}
+}
+
+type wrapExecutor struct {
+	exec    Executor
+	wrapper []string
+}
+
+func (w *wrapExecutor) Start(inv *Invocation) (Process, error) {
+	if len(w.wrapper) == 0 {
+		return w.exec.Start(inv)
+	}
+
+	wrapped := *inv
+	wrapped.Path = w.wrapper[0]
+	wrapped.Args = append(append(append([]string(nil), w.wrapper[1:]...), inv.Path), inv.Args...)
+	return w.exec.Start(&wrapped)
+}
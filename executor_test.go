@@ -0,0 +1,60 @@
+package ffmpeg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+type exitError int
+
+func (e exitError) Error() string { return fmt.Sprintf("exit status %d", int(e)) }
+func (e exitError) ExitCode() int { return int(e) }
+
+// fakeExecutor records the invocation it was given and writes log to its
+// stderr, without starting a process.
+type fakeExecutor struct {
+	inv  *Invocation
+	log  string
+	exit error
+}
+
+func (e *fakeExecutor) Start(inv *Invocation) (Process, error) {
+	e.inv = inv
+	fmt.Fprint(inv.Stderr, e.log)
+	return &fakeProcess{exit: e.exit}, nil
+}
+
+type fakeProcess struct {
+	exit error
+}
+
+func (p *fakeProcess) Pid() int                   { return -1 }
+func (p *fakeProcess) Signal(sig os.Signal) error { return nil }
+func (p *fakeProcess) Kill() error                { return nil }
+func (p *fakeProcess) Wait() error                { return p.exit }
+
+func TestExecutor(t *testing.T) {
+	e := &fakeExecutor{
+		log:  "in.mp4: No such file or directory\n",
+		exit: exitError(1),
+	}
+	r := &Runner{Executor: WrapExecutor(e, "nice", "-n", "10")}
+
+	cmd, err := r.Command(nil, Input("in.mp4"), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	err = cmd.Run()
+	if !errors.Is(err, ErrInputNotFound) {
+		t.Errorf("Expected %v got %v", ErrInputNotFound, err)
+	}
+
+	expected := "nice -n 10 ffmpeg -hide_banner -nostdin -xerror -i in.mp4 out.mp4"
+	if got := strings.Join(append([]string{e.inv.Path}, e.inv.Args...), " "); got != expected {
+		t.Errorf("Expected %s got %s", expected, got)
+	}
+}
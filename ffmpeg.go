@@ -2,64 +2,30 @@
 // line interface.
 package ffmpeg
 
-//go:generate go run _gen/main.go -option pix_fmts
-//go:generate go run _gen/main.go -option codecs
-//go:generate go run _gen/main.go -option formats
-
-import (
-	"os/exec"
-
-	multierror "github.com/hashicorp/go-multierror"
-)
-
-// Command creates a new Cmd instance
+//go:generate go run _gen/main.go -option pix_fmts -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option codecs -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option encoders -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option decoders -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option formats -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option filters -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option bsfs -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option protocols -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option sample_fmts -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option layouts -dir _gen/testdata/ffmpeg-3.4
+//go:generate go run _gen/main.go -option hwaccels -dir _gen/testdata/ffmpeg-3.4
+
+import "context"
+
+// Command creates a new Cmd instance using DefaultRunner
 func Command(global GlobalOptions, files ...*File) (*Cmd, error) {
-	var i, o []*File
-	var err *multierror.Error
-
-	for _, file := range files {
-		switch file.typ {
-		case fileTypeInput:
-			if file.err != nil {
-				err = multierror.Append(err, file.err)
-			} else {
-				i = append(i, file)
-			}
-		case fileTypeOutput:
-			if file.err != nil {
-				err = multierror.Append(err, file.err)
-			} else {
-				o = append(o, file)
-			}
-		}
-	}
-
-	if err.ErrorOrNil() != nil {
-		return nil, err
-	}
-
-	var r []string
-	if global != nil {
-		r = append(r, global.Flags()...)
-	}
-	for _, input := range i {
-		r = append(r, input.Flags()...)
-	}
-	for _, ouput := range o {
-		r = append(r, ouput.Flags()...)
-	}
-
-	// suppress printing the banner
-	// disable stdin interaction
-	// stop and exit on error
-	args := []string{"-hide_banner", "-nostdin", "-xerror"}
-	args = append(args, r...)
-
-	cmd := exec.Command("ffmpeg", args...)
-	cmd.Env = append(cmd.Env, "AV_LOG_FORCE_NOCOLOR=TRUE")
+	return DefaultRunner.Command(global, files...)
+}
 
-	return &Cmd{
-		Args: args,
-		cmd:  cmd,
-	}, nil
+// CommandContext is like Command but includes a context.
+//
+// The provided context is used to stop the command if the context becomes
+// done before the command completes on its own. See Cmd.RunContext for how
+// the command is stopped.
+func CommandContext(ctx context.Context, global GlobalOptions, files ...*File) (*Cmd, error) {
+	return DefaultRunner.CommandContext(ctx, global, files...)
 }
@@ -0,0 +1,307 @@
+// Package ffmpegtest provides a stub ffmpeg executable, so code that runs
+// ffmpeg commands can be tested without ffmpeg installed.
+//
+// The stub is the test binary itself. It takes over when run with the
+// environment of a Stub, which requires calling Main from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		ffmpegtest.Main()
+//		os.Exit(m.Run())
+//	}
+//
+// A test then points a runner at the stub:
+//
+//	stub := ffmpegtest.New(t, ffmpegtest.Script{ExitCode: 1})
+//	r := &ffmpeg.Runner{Path: stub.Path, Env: stub.Env}
+package ffmpegtest
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+// envScript names the environment variable pointing the stub at its script.
+const envScript = "FFMPEGTEST_SCRIPT"
+
+// Script describes what the stub does when it is run.
+type Script struct {
+	// Stdout is written to stdout.
+	Stdout string
+
+	// Stderr holds the log lines written to stderr.
+	Stderr []string
+
+	// Progress holds the lines written to the "-progress" url, if the
+	// stub is run with one, for example "frame=10" and "progress=end".
+	Progress []string
+
+	// Files maps the paths of the files the stub writes to their
+	// contents. A "pipe:N" path writes to file descriptor N. Inputs read
+	// from a "pipe:N" url are always read to their end.
+	Files map[string]string
+
+	// ExitCode is the code the stub exits with.
+	ExitCode int
+
+	// Hang makes the stub block after writing its log and progress until
+	// it is interrupted, by an interrupt signal or "q" on stdin, before
+	// writing its files and exiting.
+	Hang bool
+
+	// IgnoreInterrupt makes a hanging stub ignore interruptions, so it
+	// only exits when killed.
+	IgnoreInterrupt bool
+
+	// Version is the version the stub reports when run with "-version",
+	// such as "4.4.2". If empty, "3.4" is reported.
+	Version string
+
+	// Configuration holds the configure flags the stub reports when run
+	// with "-version" or "-buildconf", such as "--enable-libx264".
+	Configuration []string
+
+	// Listings maps listing flags, such as "-codecs", to what the stub
+	// prints to stdout when run with them.
+	Listings map[string]string
+}
+
+// Stub is a stub ffmpeg executable following a Script.
+type Stub struct {
+	// Path is the path of the stub executable.
+	Path string
+	// Env holds the environment variables the stub must be run with.
+	Env []string
+
+	calls string
+}
+
+// New creates a Stub that follows s when run.
+func New(tb testing.TB, s Script) *Stub {
+	tb.Helper()
+
+	path, err := os.Executable()
+	if err != nil {
+		tb.Fatalf("ffmpegtest: unable to find test executable: %v", err)
+	}
+
+	dir := tb.TempDir()
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		tb.Fatalf("ffmpegtest: unable to encode script: %v", err)
+	}
+	script := filepath.Join(dir, "script.json")
+	if err := ioutil.WriteFile(script, b, 0644); err != nil {
+		tb.Fatalf("ffmpegtest: unable to write script: %v", err)
+	}
+
+	return &Stub{
+		Path:  path,
+		Env:   []string{envScript + "=" + script},
+		calls: filepath.Join(dir, "calls.json"),
+	}
+}
+
+// Calls returns the arguments of each run of the stub so far, not
+// including the executable itself.
+func (s *Stub) Calls() [][]string {
+	f, err := os.Open(s.calls)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var calls [][]string
+	d := json.NewDecoder(f)
+	for {
+		var args []string
+		if err := d.Decode(&args); err != nil {
+			return calls
+		}
+		calls = append(calls, args)
+	}
+}
+
+// Main runs the stub and exits when the test binary was started as one,
+// and returns immediately otherwise. It must be called from TestMain
+// before anything else.
+func Main() {
+	path := os.Getenv(envScript)
+	if path == "" {
+		return
+	}
+
+	code, err := run(path, os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ffmpegtest: %v\n", err)
+		os.Exit(254)
+	}
+	os.Exit(code)
+}
+
+func run(path string, args []string) (int, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	var s Script
+	if err := json.Unmarshal(b, &s); err != nil {
+		return 0, err
+	}
+
+	if err := record(filepath.Join(filepath.Dir(path), "calls.json"), args); err != nil {
+		return 0, err
+	}
+
+	for _, arg := range args {
+		switch arg {
+		case "-version":
+			version := s.Version
+			if version == "" {
+				version = "3.4"
+			}
+			fmt.Printf("ffmpeg version %s Copyright (c) 2000-2017 the FFmpeg developers\n", version)
+			fmt.Printf("configuration: %s\n", strings.Join(s.Configuration, " "))
+			return 0, nil
+		case "-buildconf":
+			fmt.Println("  configuration:")
+			for _, flag := range s.Configuration {
+				fmt.Printf("    %s\n", flag)
+			}
+			return 0, nil
+		}
+		if listing, ok := s.Listings[arg]; ok {
+			fmt.Print(listing)
+			return 0, nil
+		}
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	if !s.IgnoreInterrupt {
+		go func() {
+			r := bufio.NewReader(os.Stdin)
+			for {
+				c, err := r.ReadByte()
+				if err != nil {
+					return
+				}
+				if c == 'q' {
+					interrupt <- os.Interrupt
+					return
+				}
+			}
+		}()
+	}
+
+	// piped inputs are read to the end, as ffmpeg would
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == "-i" && strings.HasPrefix(args[i+1], "pipe:") {
+			if err := drain(args[i+1]); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	fmt.Print(s.Stdout)
+	for _, line := range s.Stderr {
+		fmt.Fprintln(os.Stderr, line)
+	}
+
+	if url := flagValue(args, "-progress"); url != "" && len(s.Progress) > 0 {
+		if err := write(url, strings.Join(s.Progress, "\n")+"\n"); err != nil {
+			return 0, err
+		}
+	}
+
+	if s.Hang {
+		<-interrupt
+		for s.IgnoreInterrupt {
+			<-interrupt
+		}
+	}
+
+	for path, contents := range s.Files {
+		if err := write(path, contents); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.ExitCode, nil
+}
+
+// record appends args to the calls file.
+func record(path string, args []string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewEncoder(f).Encode(args)
+}
+
+// flagValue returns the value following the last occurrence of flag.
+func flagValue(args []string, flag string) string {
+	var value string
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == flag {
+			value = args[i+1]
+		}
+	}
+	return value
+}
+
+// pipeFile opens a "pipe:N" url.
+func pipeFile(url string) (*os.File, error) {
+	fd, err := strconv.Atoi(strings.TrimPrefix(url, "pipe:"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid pipe %q", url)
+	}
+	return os.NewFile(uintptr(fd), url), nil
+}
+
+// drain reads a "pipe:N" url to its end.
+func drain(url string) error {
+	f, err := pipeFile(url)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(ioutil.Discard, f)
+	return err
+}
+
+// write writes contents to a file path or "pipe:N" url.
+func write(url, contents string) error {
+	var w io.WriteCloser
+	if strings.HasPrefix(url, "pipe:") {
+		f, err := pipeFile(url)
+		if err != nil {
+			return err
+		}
+		w = f
+	} else {
+		f, err := os.Create(url)
+		if err != nil {
+			return err
+		}
+		w = f
+	}
+
+	if _, err := io.WriteString(w, contents); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,49 @@
+package ffmpegtest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	Main()
+	os.Exit(m.Run())
+}
+
+func TestStub(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out.mp4")
+	stub := New(t, Script{
+		Stderr:   []string{"Output #0, mp4, to 'out.mp4':"},
+		Files:    map[string]string{out: "data"},
+		ExitCode: 3,
+	})
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(stub.Path, "-i", "in.mp4", out)
+	cmd.Env = append(os.Environ(), stub.Env...)
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if exit, ok := err.(*exec.ExitError); !ok || exit.ExitCode() != 3 {
+		t.Fatalf("Expected exit status 3 got %v", err)
+	}
+
+	if stderr.String() != "Output #0, mp4, to 'out.mp4':\n" {
+		t.Errorf("Unexpected stderr %q", stderr.String())
+	}
+
+	b, err := ioutil.ReadFile(out)
+	if err != nil || string(b) != "data" {
+		t.Errorf("Expected output file to be written got %q, %v", b, err)
+	}
+
+	expected := [][]string{{"-i", "in.mp4", out}}
+	if calls := stub.Calls(); !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v got %v", expected, calls)
+	}
+}
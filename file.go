@@ -1,32 +1,63 @@
 package ffmpeg
 
-import multierror "github.com/hashicorp/go-multierror"
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
 
 // Input creates a new File instance that represents an input file
 func Input(path string, opts ...FileOption) *File {
-	f := &File{
+	return newFile(&File{
 		path: path,
 		typ:  fileTypeInput,
-	}
-
-	var errs *multierror.Error
-	for _, opt := range opts {
-		if err := opt(f); err != nil {
-			errs = multierror.Append(errs, err)
-		}
-	}
-	f.err = errs.ErrorOrNil()
+	}, opts)
+}
 
-	return f
+// InputReader creates a new File instance that represents an input
+// read from r.
+//
+// The data is streamed to ffmpeg over a pipe of its own, so several
+// inputs and outputs can be streamed at once. Cmd.Wait waits for r to be
+// copied, until its end or until ffmpeg stops reading it.
+func InputReader(r io.Reader, opts ...FileOption) *File {
+	return newFile(&File{
+		reader: r,
+		typ:    fileTypeInput,
+	}, opts)
 }
 
 // Output creates a new File instance that represents an output file
 func Output(path string, opts ...FileOption) *File {
-	f := &File{
+	return newFile(&File{
 		path: path,
 		typ:  fileTypeOutput,
+	}, opts)
+}
+
+// OutputWriter creates a new File instance that represents an output
+// written to w.
+//
+// The data is streamed from ffmpeg over a pipe of its own, so several
+// inputs and outputs can be streamed at once. As ffmpeg can not guess
+// the format of the output from a file extension, it must be set with
+// WithFormat.
+func OutputWriter(w io.Writer, opts ...FileOption) *File {
+	f := newFile(&File{
+		writer: w,
+		typ:    fileTypeOutput,
+	}, opts)
+	if f.format == "" {
+		f.err = multierror.Append(f.err, fmt.Errorf("unable to write output to io.Writer: no format set with -f flag"))
 	}
+	return f
+}
 
+func newFile(f *File, opts []FileOption) *File {
 	var errs *multierror.Error
 	for _, opt := range opts {
 		if err := opt(f); err != nil {
@@ -44,16 +75,232 @@ type File struct {
 	options []string
 	typ     fileType
 	err     error
+
+	format string
+	reader io.Reader
+	writer io.Writer
+
+	// encoders and pixelFormats are set with WithEncoder and
+	// WithPixelFormat, by stream specifier, so they can be checked
+	// against each other
+	encoders     map[string]Encoder
+	pixelFormats map[string]PixelFormat
+
+	// positions are set with WithSeek, WithSeekFromEnd, WithEndPosition
+	// and WithDuration, by flag, so they can be checked against each other
+	positions map[string]Position
+
+	// maps are set with WithMap and the like, and metadataMaps with
+	// WithMapMetadata and WithMapChapters, and rendered by Command once the
+	// index of their input files is known
+	maps         []streamMap
+	metadataMaps []metadataMap
 }
 
 // Flags generates the ffmpeg flags for the specified file
+//
+// The maps of an output file are left out, as they refer to input files
+// by their index in the command; see WithMap and WithMapMetadata. Files
+// created with InputReader or OutputWriter are rendered as "pipe:", as the
+// pipe they are streamed through is only numbered by Command.
 func (f *File) Flags() []string {
+	path := f.path
+	if f.reader != nil || f.writer != nil {
+		path = "pipe:"
+	}
+	return f.flags(path, nil)
+}
+
+// flags is like Flags, with the file at path, such as the url of the pipe
+// it is streamed through, and the resolved maps of an output file first.
+func (f *File) flags(path string, maps []string) []string {
+	flags := append(append([]string(nil), maps...), f.options...)
 	switch f.typ {
 	case fileTypeInput:
-		return append(f.options, []string{"-i", f.path}...)
+		return append(flags, []string{"-i", path}...)
+	default:
+		return append(flags, path)
+	}
+}
+
+// streamMap is a -map flag of an output file, selecting either streams of
+// an input file or the output of a filtergraph labelled label.
+type streamMap struct {
+	input    *File
+	stream   StreamSpecifier
+	negative bool
+	optional bool
+	label    string
+}
+
+// metadataMap is a -map_metadata or -map_chapters flag of an output file,
+// copying from input, or from no input file if it is nil.
+type metadataMap struct {
+	flag  string
+	input *File
+	from  MetadataScope
+}
+
+// resolveMaps returns the -map, -map_metadata and -map_chapters flags of
+// the file, with its input files referred to by their index in inputs. It
+// returns an error for each map of a file that is not in inputs.
+func (f *File) resolveMaps(inputs []*File) ([]string, error) {
+	var flags []string
+	var errs *multierror.Error
+	positive := map[*File]bool{}
+	for _, m := range f.maps {
+		if m.label != "" {
+			flags = append(flags, "-map", "["+m.label+"]")
+			continue
+		}
+
+		idx := inputIndex(inputs, m.input)
+		if idx < 0 {
+			errs = multierror.Append(errs, fmt.Errorf("unable to apply -map flag: input file %s is not an input of the command", m.input.name()))
+			continue
+		}
+		if m.negative && !positive[m.input] {
+			errs = multierror.Append(errs, fmt.Errorf("unable to apply -map flag: negative map of input file %s follows no map of it", m.input.name()))
+			continue
+		}
+		positive[m.input] = positive[m.input] || !m.negative
+
+		arg := strconv.Itoa(idx)
+		if spec := m.stream.String(); spec != ":" {
+			arg += spec
+		}
+		if m.negative {
+			arg = "-" + arg
+		}
+		if m.optional {
+			arg += "?"
+		}
+		flags = append(flags, "-map", arg)
+	}
+
+	for _, m := range f.metadataMaps {
+		if m.input == nil {
+			flags = append(flags, m.flag, "-1")
+			continue
+		}
+
+		idx := inputIndex(inputs, m.input)
+		if idx < 0 {
+			errs = multierror.Append(errs, fmt.Errorf("unable to apply %s flag: input file %s is not an input of the command", m.flag, m.input.name()))
+			continue
+		}
+		flags = append(flags, m.flag, strconv.Itoa(idx)+m.from.String())
+	}
+	return flags, errs.ErrorOrNil()
+}
+
+// inputIndex returns the index of input in inputs, or -1 if it is not one
+// of them.
+func inputIndex(inputs []*File, input *File) int {
+	for n, i := range inputs {
+		if i == input {
+			return n
+		}
+	}
+	return -1
+}
+
+// name returns how the file is referred to in errors.
+func (f *File) name() string {
+	switch {
+	case f.reader != nil:
+		return "io.Reader"
+	case f.writer != nil:
+		return "io.Writer"
 	default:
-		return append(f.options, f.path)
+		return strconv.Quote(f.path)
+	}
+}
+
+// checkPixelFormats returns an error for each pixel format set on the
+// file that the encoder of its streams does not support.
+func (f *File) checkPixelFormats() error {
+	var specs []string
+	for spec := range f.pixelFormats {
+		specs = append(specs, spec)
+	}
+	sort.Strings(specs)
+	var encSpecs []string
+	for spec := range f.encoders {
+		encSpecs = append(encSpecs, spec)
+	}
+	sort.Strings(encSpecs)
+
+	var errs *multierror.Error
+	for _, spec := range specs {
+		pf := f.pixelFormats[spec]
+
+		// the encoder set for the streams or for streams including them,
+		// and those set for some of them
+		var encs []Encoder
+		enc, ok := f.encoders[spec]
+		if !ok && strings.HasPrefix(spec, ":v") {
+			enc, ok = f.encoders[":v"]
+		}
+		if !ok {
+			enc, ok = f.encoders[":"]
+		}
+		if ok {
+			encs = append(encs, enc)
+		}
+		for _, encSpec := range encSpecs {
+			if encSpec != spec && specCovers(spec, encSpec) {
+				encs = append(encs, f.encoders[encSpec])
+			}
+		}
+
+		checked := map[Encoder]bool{}
+		for _, enc := range encs {
+			if checked[enc] || len(enc.PixelFormats()) == 0 {
+				continue
+			}
+			checked[enc] = true
+
+			supported := false
+			for _, s := range enc.PixelFormats() {
+				supported = supported || s == pf
+			}
+			if !supported {
+				best, _ := BestPixelFormat(enc, pf)
+				errs = multierror.Append(errs, fmt.Errorf("unable to apply -pix_fmt%s flag: encoder %s does not support pixel format %s, the closest it supports is %s", spec, enc, pf, best))
+			}
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// specCovers reports whether the streams selected by the rendered stream
+// specifier spec include those selected by other, as far as can be told
+// from the specifiers alone.
+func specCovers(spec, other string) bool {
+	return spec == other || spec == ":" || (spec == ":v" && strings.HasPrefix(other, ":v:"))
+}
+
+func (f *File) setPosition(flag string, pos Position) {
+	if f.positions == nil {
+		f.positions = map[string]Position{}
+	}
+	f.positions[flag] = pos
+}
+
+// checkPositions returns an error if the positions set on the file
+// contradict each other.
+func (f *File) checkPositions() error {
+	var errs *multierror.Error
+	_, hasDuration := f.positions["t"]
+	end, hasEnd := f.positions["to"]
+	if hasDuration && hasEnd {
+		errs = multierror.Append(errs, fmt.Errorf("unable to apply -to flag: -t is also set, which ffmpeg would use instead"))
+	}
+	if start, ok := f.positions["ss"]; ok && hasEnd && end <= start {
+		errs = multierror.Append(errs, fmt.Errorf("unable to apply -to flag: position %s is not after -ss position %s", end, start))
 	}
+	return errs.ErrorOrNil()
 }
 
 type fileType int
@@ -0,0 +1,101 @@
+package ffmpeg
+
+import "strings"
+
+// formatProps are the capabilities of a format, as listed by "ffmpeg -formats".
+type formatProps int
+
+const (
+	formatDemux formatProps = 1 << iota
+	formatMux
+)
+
+type fileFormatInfo struct {
+	props      formatProps
+	extensions []string
+	// demuxerExtensions is the number of extensions, last, that only the
+	// demuxer of a format that is also muxed lists
+	demuxerExtensions int
+	mimeType          string
+	videoCodec        Codec
+	audioCodec        Codec
+	subtitleCodec     Codec
+}
+
+func (typ FileFormat) info() fileFormatInfo {
+	if typ < 0 || int(typ) >= len(fileFormatInfos) {
+		return fileFormatInfo{videoCodec: noCodec, audioCodec: noCodec, subtitleCodec: noCodec}
+	}
+	return fileFormatInfos[typ]
+}
+
+// CanMux reports whether ffmpeg can write files of the format.
+func (typ FileFormat) CanMux() bool {
+	return typ.info().props&formatMux != 0
+}
+
+// CanDemux reports whether ffmpeg can read files of the format, including
+// those read by a demuxer listed under several names, such as mp4 which is
+// read by "mov,mp4,m4a,3gp,3g2,mj2".
+func (typ FileFormat) CanDemux() bool {
+	return typ.info().props&formatDemux != 0
+}
+
+// Extensions returns the file extensions of the format, without leading
+// dot, those of its muxer first.
+func (typ FileFormat) Extensions() []string {
+	return typ.info().extensions
+}
+
+// MIMEType returns the MIME type of the files written by the muxer of the
+// format, or "" if it has none.
+func (typ FileFormat) MIMEType() string {
+	return typ.info().mimeType
+}
+
+// DefaultVideoCodec returns the codec of the video streams the muxer of
+// the format writes when no codec is selected.
+func (typ FileFormat) DefaultVideoCodec() (Codec, bool) {
+	c := typ.info().videoCodec
+	return c, c != noCodec
+}
+
+// DefaultAudioCodec returns the codec of the audio streams the muxer of
+// the format writes when no codec is selected.
+func (typ FileFormat) DefaultAudioCodec() (Codec, bool) {
+	c := typ.info().audioCodec
+	return c, c != noCodec
+}
+
+// DefaultSubtitleCodec returns the codec of the subtitle streams the muxer
+// of the format writes when no codec is selected.
+func (typ FileFormat) DefaultSubtitleCodec() (Codec, bool) {
+	c := typ.info().subtitleCodec
+	return c, c != noCodec
+}
+
+// FormatForExtension returns the format of files with the extension ext,
+// such as ".mkv". Like ffmpeg guessing the format of an output file, it
+// prefers formats it can write, in the order ffmpeg lists them.
+func FormatForExtension(ext string) (FileFormat, bool) {
+	ext = strings.ToLower(strings.TrimPrefix(ext, "."))
+	if ext == "" {
+		return 0, false
+	}
+
+	found, ok := FileFormat(0), false
+	for ff, info := range fileFormatInfos {
+		for i, e := range info.extensions {
+			if strings.ToLower(e) != ext {
+				continue
+			}
+			if info.props&formatMux != 0 && i < len(info.extensions)-info.demuxerExtensions {
+				return FileFormat(ff), true
+			}
+			if !ok {
+				found, ok = FileFormat(ff), true
+			}
+		}
+	}
+	return found, ok
+}
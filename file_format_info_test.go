@@ -0,0 +1,94 @@
+package ffmpeg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFileFormatInfo(t *testing.T) {
+	tests := []struct {
+		Format     FileFormat
+		Mux        bool
+		Demux      bool
+		Extensions []string
+		MIMEType   string
+		Codecs     []Codec
+	}{
+		{FileFormatMatroska, true, true, []string{"mkv", "mk3d", "mka", "mks"}, "video/x-matroska",
+			[]Codec{CodecH264, CodecAc3, CodecAss}},
+		{FileFormatWebm, true, true, []string{"webm", "mkv", "mk3d", "mka", "mks"}, "video/webm",
+			[]Codec{CodecVp8, CodecVorbis, CodecWebvtt}},
+		{FileFormatMp4, true, true, []string{"mp4"}, "video/mp4",
+			[]Codec{CodecH264, CodecAac, noCodec}},
+		{FileFormatMov, true, true, []string{"mov"}, "",
+			[]Codec{CodecH264, CodecAac, noCodec}},
+		{FileFormatM4A, false, true, nil, "",
+			[]Codec{noCodec, noCodec, noCodec}},
+		{FileFormatMpegts, true, true, []string{"ts", "m2t", "m2ts", "mts"}, "video/MP2T",
+			[]Codec{CodecMpeg2Video, CodecMp2, noCodec}},
+		{FileFormatWav, true, true, []string{"wav"}, "audio/x-wav",
+			[]Codec{noCodec, CodecPcmS16Le, noCodec}},
+		{FileFormatAvfoundation, false, true, nil, "",
+			[]Codec{noCodec, noCodec, noCodec}},
+		{FileFormat(-1), false, false, nil, "",
+			[]Codec{noCodec, noCodec, noCodec}},
+	}
+
+	for _, test := range tests {
+		if got := []bool{test.Format.CanMux(), test.Format.CanDemux()}; !reflect.DeepEqual(got, []bool{test.Mux, test.Demux}) {
+			t.Errorf("%s: Expected mux, demux %v got %v", test.Format, []bool{test.Mux, test.Demux}, got)
+		}
+		if got := test.Format.Extensions(); !reflect.DeepEqual(got, test.Extensions) {
+			t.Errorf("%s: Expected extensions %v got %v", test.Format, test.Extensions, got)
+		}
+		if got := test.Format.MIMEType(); got != test.MIMEType {
+			t.Errorf("%s: Expected MIME type %q got %q", test.Format, test.MIMEType, got)
+		}
+		var codecs []Codec
+		for _, def := range []func() (Codec, bool){test.Format.DefaultVideoCodec, test.Format.DefaultAudioCodec, test.Format.DefaultSubtitleCodec} {
+			c, ok := def()
+			if ok == (c == noCodec) {
+				t.Errorf("%s: Expected default codec %d to be reported as %v", test.Format, c, !ok)
+			}
+			codecs = append(codecs, c)
+		}
+		if !reflect.DeepEqual(codecs, test.Codecs) {
+			t.Errorf("%s: Expected default codecs %v got %v", test.Format, test.Codecs, codecs)
+		}
+	}
+}
+
+func TestFormatForExtension(t *testing.T) {
+	tests := []struct {
+		Extension string
+		Format    FileFormat
+		OK        bool
+	}{
+		{".mkv", FileFormatMatroska, true},
+		{".mka", FileFormatMatroska, true},
+		{".webm", FileFormatWebm, true},
+		{"mkv", FileFormatMatroska, true},
+		{".MP4", FileFormatMp4, true},
+		{".ts", FileFormatMpegts, true},
+		{".aac", FileFormatAdts, true},
+		{".jpg", FileFormatImage2, true},
+		{".ape", FileFormatApe, true},
+		{".nope", 0, false},
+		{"", 0, false},
+	}
+
+	for _, test := range tests {
+		ff, ok := FormatForExtension(test.Extension)
+		if ok != test.OK || (ok && ff != test.Format) {
+			t.Errorf("%s: Expected %s, %v got %s, %v", test.Extension, test.Format, test.OK, ff, ok)
+		}
+	}
+}
+
+func TestFileFormatCanDemuxSharedDemuxer(t *testing.T) {
+	for _, ff := range []FileFormat{FileFormatMp4, FileFormatMov, FileFormatM4A, FileFormat3Gp, FileFormatMatroska, FileFormatWebm} {
+		if !ff.CanDemux() {
+			t.Errorf("%s.CanDemux() = false, want true", ff)
+		}
+	}
+}
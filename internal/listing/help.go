@@ -0,0 +1,70 @@
+package listing
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Help holds what ffmpeg prints for its -h option about a muxer, demuxer,
+// encoder or decoder, such as "ffmpeg -h muxer=matroska".
+type Help struct {
+	// Kind is what the help is about, such as "Muxer" or "Encoder".
+	Kind string
+
+	// Name is the name of the muxer, demuxer, encoder or decoder.
+	Name string
+
+	// Description is its long name, such as "Matroska".
+	Description string
+
+	// Fields holds the properties listed before the options, such as
+	// "Common extensions", keyed by their label.
+	Fields map[string]string
+}
+
+// regexpHelpHeader matches the first line of the help, such as
+// "Muxer matroska [Matroska]:".
+var regexpHelpHeader = regexp.MustCompile(`^(\w+) (\S+) \[(.*)\]:$`)
+
+// regexpHelpField matches a property of the help, such as
+// "    Common extensions: mkv.".
+var regexpHelpField = regexp.MustCompile(`^    ([^:]+): (.*)$`)
+
+// ParseHelp parses the help of a muxer, demuxer, encoder or decoder. The
+// options ffmpeg lists after the properties are ignored.
+//
+// It returns nil if ffmpeg does not know what the help was asked about.
+func ParseHelp(r io.Reader) (*Help, error) {
+	var h *Help
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimRight(s.Text(), " \t\r")
+
+		if h == nil {
+			if m := regexpHelpHeader.FindStringSubmatch(line); m != nil {
+				h = &Help{Kind: m[1], Name: m[2], Description: m[3], Fields: map[string]string{}}
+			}
+			continue
+		}
+
+		m := regexpHelpField.FindStringSubmatch(line)
+		if m == nil {
+			break
+		}
+		h.Fields[m[1]] = strings.TrimSuffix(m[2], ".")
+	}
+
+	return h, s.Err()
+}
+
+// List returns the field label as a list, splitting it on commas or
+// spaces, such as the extensions "mkv,mk3d" or the pixel formats
+// "yuv420p nv12".
+func (h *Help) List(label string) []string {
+	return strings.FieldsFunc(h.Fields[label], func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
@@ -0,0 +1,70 @@
+package listing
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseHelp(t *testing.T) {
+	tests := []struct {
+		File     string
+		Expected *Help
+	}{
+		{"muxer_matroska.txt", &Help{"Muxer", "matroska", "Matroska", map[string]string{
+			"Common extensions":      "mkv",
+			"Mime type":              "video/x-matroska",
+			"Default video codec":    "h264",
+			"Default audio codec":    "ac3",
+			"Default subtitle codec": "ass",
+		}}},
+		{"encoder_libx264.txt", &Help{"Encoder", "libx264", "libx264 H.264 / AVC / MPEG-4 AVC / MPEG-4 part 10", map[string]string{
+			"General capabilities":    "delay threads",
+			"Threading capabilities":  "auto",
+			"Supported pixel formats": "yuv420p yuvj420p yuv422p yuvj422p yuv444p yuvj444p nv12 nv16 nv21",
+		}}},
+	}
+
+	for _, test := range tests {
+		f, err := os.Open(filepath.Join("testdata", test.File))
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, err := ParseHelp(f)
+		f.Close()
+		if err != nil {
+			t.Errorf("%s: unable to parse help: %v", test.File, err)
+			continue
+		}
+		if !reflect.DeepEqual(h, test.Expected) {
+			t.Errorf("%s: Expected %+v got %+v", test.File, test.Expected, h)
+		}
+	}
+
+	if h, err := ParseHelp(strings.NewReader("Unknown format 'zzz'.\n")); h != nil || err != nil {
+		t.Errorf("Expected no help got %+v, %v", h, err)
+	}
+}
+
+func TestHelpList(t *testing.T) {
+	h := &Help{Fields: map[string]string{
+		"Common extensions":       "a64, A64",
+		"Supported pixel formats": "yuv420p nv12",
+	}}
+	tests := []struct {
+		Label    string
+		Expected []string
+	}{
+		{"Common extensions", []string{"a64", "A64"}},
+		{"Supported pixel formats", []string{"yuv420p", "nv12"}},
+		{"Mime type", []string{}},
+	}
+
+	for _, test := range tests {
+		if got := h.List(test.Label); !reflect.DeepEqual(got, test.Expected) {
+			t.Errorf("%s: Expected %q got %q", test.Label, test.Expected, got)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Package listing parses the listings ffmpeg prints for its -codecs,
+// -encoders, -decoders, -formats, -pix_fmts and -filters options, the
+// plainer lists it prints for -bsfs, -protocols, -sample_fmts, -layouts
+// and -hwaccels, and the help it prints for its -h option.
+//
+// It is shared by the code generator and the runtime registry, so both
+// read listings the same way.
+package listing
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Entry is a line of a listing, such as a codec listed by "ffmpeg -codecs".
+type Entry struct {
+	// Flags is the capability column, such as "DEV.LS" for a codec. A
+	// flag that is not set is a "." or a space, depending on the listing.
+	Flags string
+
+	// Name is the name of the entry. Formats sharing a demuxer are listed
+	// under a comma separated list of names, such as "matroska,webm".
+	Name string
+
+	// Description is the rest of the line, such as "Matroska / WebM". For
+	// pixel formats it holds the component count and bits per pixel.
+	Description string
+}
+
+// Names returns the names the entry is listed under.
+func (e Entry) Names() []string {
+	return strings.Split(e.Name, ",")
+}
+
+// Flag reports whether the flag at position i of the capability column
+// is set.
+func (e Entry) Flag(i int) bool {
+	return i < len(e.Flags) && e.Flags[i] != '.' && e.Flags[i] != ' '
+}
+
+// regexpLegend matches the lines explaining the capability column, such as
+// " D..... = Decoding supported".
+var regexpLegend = regexp.MustCompile(`^(\s*)([A-Z.]+) = `)
+
+// Parse parses a listing.
+//
+// The position and width of the capability column are taken from the
+// first line of the legend preceding the entries, so flags that are not
+// set are read correctly whether they are printed as "." or a space. The
+// legend of -filters is indented one more column than its entries, so a
+// column preceded by a flag is moved back by one.
+func Parse(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	offset, width := -1, 0
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimRight(s.Text(), " \t\r")
+
+		if m := regexpLegend.FindStringSubmatch(line); m != nil {
+			if offset < 0 {
+				offset, width = len(m[1]), len(m[2])
+			}
+			continue
+		}
+		if offset < 0 {
+			continue
+		}
+		offset := offset
+		if offset > 0 && len(line) > offset && line[offset-1] != ' ' {
+			offset--
+		}
+		if len(line) <= offset+width || line[offset+width] != ' ' {
+			continue
+		}
+
+		flags := line[offset : offset+width]
+		if strings.Trim(flags, "ABCDEFGHIJKLMNOPQRSTUVWXYZ. ") != "" || strings.TrimSpace(flags) == "" {
+			continue
+		}
+
+		fields := strings.Fields(line[offset+width:])
+		if len(fields) == 0 || fields[0] == "NAME" {
+			// the column header of -pix_fmts
+			continue
+		}
+		entries = append(entries, Entry{
+			Flags:       flags,
+			Name:        fields[0],
+			Description: strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[offset+width:]), fields[0])),
+		})
+	}
+
+	return entries, s.Err()
+}
+
+// regexpCodecImplementations matches the decoders or encoders listed after
+// a codec's description, such as " (encoders: libx264 libx264rgb )".
+var regexpCodecImplementations = regexp.MustCompile(` \((decoders|encoders): ([^)]*)\)`)
+
+// Decoders returns the decoders of a codec listed by "ffmpeg -codecs".
+//
+// ffmpeg only lists them when their names differ from the codec's, so a
+// codec that can be decoded but lists none has a decoder of the same name.
+func (e Entry) Decoders() []string {
+	return e.implementations("decoders", 0)
+}
+
+// Encoders returns the encoders of a codec listed by "ffmpeg -codecs". See
+// Decoders.
+func (e Entry) Encoders() []string {
+	return e.implementations("encoders", 1)
+}
+
+func (e Entry) implementations(kind string, flag int) []string {
+	for _, m := range regexpCodecImplementations.FindAllStringSubmatch(e.Description, -1) {
+		if m[1] == kind {
+			return strings.Fields(m[2])
+		}
+	}
+	if e.Flag(flag) {
+		return []string{e.Name}
+	}
+	return nil
+}
+
+// regexpImplementationCodec matches the codec listed after the description
+// of an encoder or decoder, such as " (codec h264)".
+var regexpImplementationCodec = regexp.MustCompile(` \(codec ([^)]+)\)$`)
+
+// Codec returns the codec of an encoder or decoder listed by
+// "ffmpeg -encoders" or "ffmpeg -decoders".
+//
+// ffmpeg only lists it when its name differs from the encoder's or
+// decoder's, so one that lists none has the codec of the same name.
+func (e Entry) Codec() string {
+	if m := regexpImplementationCodec.FindStringSubmatch(e.Description); m != nil {
+		return m[1]
+	}
+	return e.Name
+}
+
+// CodecDescription returns the description of an entry without the
+// decoders, encoders or codec ffmpeg lists after it.
+func (e Entry) CodecDescription() string {
+	desc := regexpCodecImplementations.ReplaceAllString(e.Description, "")
+	return regexpImplementationCodec.ReplaceAllString(desc, "")
+}
+
+// Section is a titled list of names printed by ffmpeg, such as the
+// "Input:" protocols listed by "ffmpeg -protocols".
+type Section struct {
+	// Title is the line introducing the section, without its colon, such
+	// as "Input". It is "" for names listed before any title.
+	Title string
+
+	// Entries are the names of the section. Their Flags are empty, and
+	// their Description is the rest of the line, such as the depth of a
+	// sample format or the channels of a layout.
+	Entries []Entry
+}
+
+// ParseSections parses the lists ffmpeg prints for its -bsfs, -protocols,
+// -sample_fmts, -layouts and -hwaccels options, which have no capability
+// column. The column headers some of them print, such as "NAME
+// DECOMPOSITION", are skipped.
+func ParseSections(r io.Reader) ([]Section, error) {
+	var sections []Section
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+
+		if strings.HasSuffix(line, ":") {
+			sections = append(sections, Section{Title: strings.TrimSuffix(line, ":")})
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if strings.ToUpper(fields[0]) == "NAME" {
+			continue
+		}
+		if len(sections) == 0 {
+			sections = append(sections, Section{})
+		}
+		sec := &sections[len(sections)-1]
+		sec.Entries = append(sec.Entries, Entry{
+			Name:        fields[0],
+			Description: strings.TrimSpace(strings.TrimPrefix(line, fields[0])),
+		})
+	}
+
+	return sections, s.Err()
+}
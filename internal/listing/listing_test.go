@@ -0,0 +1,167 @@
+package listing
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		File     string
+		Expected []Entry
+	}{
+		{"codecs.txt", []Entry{
+			{"D.VI.S", "012v", "Uncompressed 4:2:2 10-bit"},
+			{"DEV.LS", "h264", "H.264 / AVC / MPEG-4 AVC / MPEG-4 part 10 (encoders: libx264 libx264rgb h264_videotoolbox )"},
+			{"DEA.L.", "aac", "AAC (Advanced Audio Coding) (decoders: aac aac_fixed aac_at ) (encoders: aac aac_at )"},
+			{"..D...", "bin_data", "binary data"},
+			{"DES...", "ass", "ASS (Advanced SSA) subtitle (decoders: ssa ass ) (encoders: ssa ass )"},
+		}},
+		{"formats.txt", []Entry{
+			{"D ", "3dostr", "3DO STR"},
+			{" E", "3g2", "3GP2 (3GPP2 file format)"},
+			{"D ", "avfoundation", "AVFoundation input device"},
+			{" E", "matroska", "Matroska"},
+			{"D ", "matroska,webm", "Matroska / WebM"},
+			{" E", "mov", "QuickTime / MOV"},
+			{"D ", "mov,mp4,m4a,3gp,3g2,mj2", "QuickTime / MOV"},
+			{"DE", "mpegts", "MPEG-TS (MPEG-2 Transport Stream)"},
+		}},
+		{"encoders.txt", []Entry{
+			{"V.....", "libx264", "libx264 H.264 / AVC / MPEG-4 AVC / MPEG-4 part 10 (codec h264)"},
+			{"V.S...", "mpeg2video", "MPEG-2 video"},
+			{"A..X..", "vorbis", "Vorbis"},
+			{"S.....", "srt", "SubRip subtitle (codec subrip)"},
+		}},
+		{"pix_fmts.txt", []Entry{
+			{"IO...", "yuv420p", "3            12"},
+			{"IO..B", "monob", "1             1"},
+			{"..H..", "videotoolbox_vld", "0             0"},
+			{"IO...", "yuva420p", "4            20"},
+		}},
+		{"filters.txt", []Entry{
+			{"...", "abench", "A->A       Benchmark part of a filtergraph."},
+			{"..C", "acompressor", "A->A       Audio compressor."},
+			{"TS.", "hflip", "V->V       Horizontally flip the input video."},
+			{"TSC", "overlay", "VV->V      Overlay a video source on top of the input."},
+			{"...", "nullsrc", "|->V       Null video source, return unprocessed video frames."},
+		}},
+	}
+
+	for _, test := range tests {
+		f, err := os.Open(filepath.Join("testdata", test.File))
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries, err := Parse(f)
+		f.Close()
+		if err != nil {
+			t.Errorf("unable to parse %s: %v", test.File, err)
+		} else if !reflect.DeepEqual(entries, test.Expected) {
+			t.Errorf("%s: Expected %q got %q", test.File, test.Expected, entries)
+		}
+	}
+}
+
+func TestParseSections(t *testing.T) {
+	tests := []struct {
+		File     string
+		Expected []Section
+	}{
+		{"protocols.txt", []Section{
+			{"Supported file protocols", nil},
+			{"Input", []Entry{{Name: "file"}, {Name: "http"}}},
+			{"Output", []Entry{{Name: "file"}, {Name: "tee"}}},
+		}},
+		{"layouts.txt", []Section{
+			{"Individual channels", []Entry{{Name: "FL", Description: "front left"}}},
+			{"Standard channel layouts", []Entry{
+				{Name: "mono", Description: "FC"},
+				{Name: "5.1(side)", Description: "FL+FR+FC+LFE+SL+SR"},
+			}},
+		}},
+		{"sample_fmts.txt", []Section{
+			{"", []Entry{{Name: "u8", Description: "8"}, {Name: "s16", Description: "16"}}},
+		}},
+	}
+
+	for _, test := range tests {
+		f, err := os.Open(filepath.Join("testdata", test.File))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sections, err := ParseSections(f)
+		f.Close()
+		if err != nil {
+			t.Errorf("unable to parse %s: %v", test.File, err)
+		} else if !reflect.DeepEqual(sections, test.Expected) {
+			t.Errorf("%s: Expected %q got %q", test.File, test.Expected, sections)
+		}
+	}
+}
+
+func TestEntry(t *testing.T) {
+	e := Entry{Flags: "D ", Name: "matroska,webm"}
+	if !reflect.DeepEqual(e.Names(), []string{"matroska", "webm"}) {
+		t.Errorf("Unexpected names %q", e.Names())
+	}
+	if !e.Flag(0) || e.Flag(1) || e.Flag(2) {
+		t.Errorf("Unexpected flags %q", e.Flags)
+	}
+}
+
+func TestEntryImplementations(t *testing.T) {
+	tests := []struct {
+		Entry       Entry
+		Decoders    []string
+		Encoders    []string
+		Description string
+	}{
+		{
+			Entry{"DEV.LS", "h264", "H.264 / AVC (encoders: libx264 libx264rgb )"},
+			[]string{"h264"}, []string{"libx264", "libx264rgb"}, "H.264 / AVC",
+		},
+		{
+			Entry{"DEA.L.", "aac", "AAC (decoders: aac aac_fixed ) (encoders: aac aac_at )"},
+			[]string{"aac", "aac_fixed"}, []string{"aac", "aac_at"}, "AAC",
+		},
+		{
+			Entry{"..V.L.", "av1", "Alliance for Open Media AV1"},
+			nil, nil, "Alliance for Open Media AV1",
+		},
+	}
+
+	for _, test := range tests {
+		if got := test.Entry.Decoders(); !reflect.DeepEqual(got, test.Decoders) {
+			t.Errorf("Expected decoders %q got %q", test.Decoders, got)
+		}
+		if got := test.Entry.Encoders(); !reflect.DeepEqual(got, test.Encoders) {
+			t.Errorf("Expected encoders %q got %q", test.Encoders, got)
+		}
+		if got := test.Entry.CodecDescription(); got != test.Description {
+			t.Errorf("Expected %s got %s", test.Description, got)
+		}
+	}
+}
+
+func TestEntryCodec(t *testing.T) {
+	tests := []struct {
+		Entry       Entry
+		Codec       string
+		Description string
+	}{
+		{Entry{"V.....", "libx264", "libx264 H.264 / AVC (codec h264)"}, "h264", "libx264 H.264 / AVC"},
+		{Entry{"VFS..D", "h264", "H.264 / AVC / MPEG-4 AVC / MPEG-4 part 10"}, "h264", "H.264 / AVC / MPEG-4 AVC / MPEG-4 part 10"},
+	}
+
+	for _, test := range tests {
+		if got := test.Entry.Codec(); got != test.Codec {
+			t.Errorf("Expected %s got %s", test.Codec, got)
+		}
+		if got := test.Entry.CodecDescription(); got != test.Description {
+			t.Errorf("Expected %s got %s", test.Description, got)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package ffmpeg
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"regexp"
+	"time"
+)
+
+// lineWriter is an io.Writer passing each line written to it to fn.
+//
+// Lines end with "\n" or "\r", as ffmpeg ends its status lines with "\r"
+// to overwrite them on a terminal. Blank lines are skipped.
+type lineWriter struct {
+	fn      func(line string)
+	partial []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		i := bytes.IndexAny(p, "\r\n")
+		if i < 0 {
+			w.partial = append(w.partial, p...)
+			break
+		}
+		w.partial = append(w.partial, p[:i]...)
+		w.Flush()
+		p = p[i+1:]
+	}
+	return n, nil
+}
+
+// Flush completes the line currently being written.
+func (w *lineWriter) Flush() {
+	line := string(bytes.TrimSpace(w.partial))
+	w.partial = w.partial[:0]
+	if line != "" {
+		w.fn(line)
+	}
+}
+
+// regexpLogLine matches a line logged with "-loglevel level+...", such as
+// "[libx264 @ 0x7f8] [info] profile High, level 4.0". Components logging on
+// behalf of another are prefixed by both, such as
+// "[graph 0 input from stream 0:0 @ 0x7f9] [scale @ 0x7fa] [info] ...".
+var regexpLogLine = regexp.MustCompile(`^((?:\[[^\[\]]+\] )*)\[(panic|fatal|error|warning|info|verbose|debug|trace)\] ?(.*)$`)
+
+// regexpLogComponent matches a component prefix of a log line, such as
+// "[libx264 @ 0x7f8]".
+var regexpLogComponent = regexp.MustCompile(`\[([^\]@]+?)(?: @ [^\]]+)?\]`)
+
+// regexpLevelFlag matches a log level value that already has the level flag.
+var regexpLevelFlag = regexp.MustCompile(`(^|\+)level(\+|$)`)
+
+// logRecorder turns ffmpeg log lines into slog records.
+type logRecorder struct {
+	ctx     context.Context
+	handler slog.Handler
+}
+
+// logLine is a line logged with "-loglevel level+...", split into its
+// parts. Lines without a level are kept at the info level.
+type logLine struct {
+	level     LogLevel
+	prefix    string // the component prefixes, such as "[libx264 @ 0x7f8] "
+	component string // the innermost component, such as "libx264"
+	msg       string
+}
+
+func parseLogLine(line string) logLine {
+	matches := regexpLogLine.FindStringSubmatch(line)
+	if matches == nil {
+		return logLine{level: LogLevelInfo, msg: line}
+	}
+
+	l := logLine{prefix: matches[1], msg: matches[3]}
+	l.level, _ = parseLogLevel(matches[2])
+	if components := regexpLogComponent.FindAllStringSubmatch(l.prefix, -1); len(components) > 0 {
+		l.component = components[len(components)-1][1]
+	}
+	return l
+}
+
+// String returns the line without its level, as ffmpeg logs it without
+// the level flag.
+func (l logLine) String() string {
+	return l.prefix + l.msg
+}
+
+func (r *logRecorder) record(l logLine) {
+	if !r.handler.Enabled(r.ctx, l.level.Level()) {
+		return
+	}
+
+	rec := slog.NewRecord(time.Now(), l.level.Level(), l.msg, 0)
+	if l.component != "" {
+		rec.AddAttrs(slog.String("component", l.component))
+	}
+	r.handler.Handle(r.ctx, rec)
+}
+
+// withLevelPrefix returns a copy of args with the log level flag set to
+// prefix each line with its level, adding the flag when it is missing.
+func withLevelPrefix(args []string) []string {
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] != "-loglevel" && args[i] != "-v" {
+			continue
+		}
+		args = append([]string(nil), args...)
+		if !regexpLevelFlag.MatchString(args[i+1]) {
+			args[i+1] = "level+" + args[i+1]
+		}
+		return args
+	}
+	return append([]string{"-loglevel", "level+" + LogLevelInfo.String()}, args...)
+}
@@ -0,0 +1,138 @@
+package ffmpeg
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/benhinchley/ffmpeg/ffmpegtest"
+)
+
+// recordHandler is a slog.Handler keeping the records it handles.
+type recordHandler struct {
+	records []slog.Record
+}
+
+func (h *recordHandler) Enabled(ctx context.Context, l slog.Level) bool {
+	return l >= slog.LevelInfo
+}
+
+func (h *recordHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *recordHandler) WithAttrs(attrs []slog.Attr) slog.Handler { return h }
+func (h *recordHandler) WithGroup(name string) slog.Handler       { return h }
+
+func TestLogHandler(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{
+		Stderr: []string{
+			"[info] Input #0, mov,mp4,m4a,3gp,3g2,mj2, from 'in.mp4':",
+			"[libx264 @ 0x7f9a5c00] [info] profile High, level 4.0",
+			"[libx264 @ 0x7f9a5c00] [debug] frame=   0 QP=23.00",
+			"[mp4 @ 0x7f9a5d00] [warning] Non-monotonous DTS in output stream 0:1",
+			"[graph 0 input from stream 0:0 @ 0x7f9a5e00] [scale @ 0x7f9a5f00] [error] No such filter",
+			"untagged line",
+		},
+	})
+	h := &recordHandler{}
+	r := &Runner{Path: stub.Path, Env: stub.Env, LogHandler: &recordHandler{}}
+
+	cmd, err := r.Command(GlobalOptions{WithLogLevel(LogLevelDebug)}, Input("in.mp4"), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+	cmd.LogHandler = h
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("unable to run command: %v", err)
+	}
+
+	expected := []struct {
+		Level     slog.Level
+		Component string
+		Message   string
+	}{
+		{Level: slog.LevelInfo, Message: "Input #0, mov,mp4,m4a,3gp,3g2,mj2, from 'in.mp4':"},
+		{Level: slog.LevelInfo, Component: "libx264", Message: "profile High, level 4.0"},
+		{Level: slog.LevelWarn, Component: "mp4", Message: "Non-monotonous DTS in output stream 0:1"},
+		{Level: slog.LevelError, Component: "scale", Message: "No such filter"},
+		{Level: slog.LevelInfo, Message: "untagged line"},
+	}
+	if len(h.records) != len(expected) {
+		t.Fatalf("Expected %d records got %d", len(expected), len(h.records))
+	}
+	for i, e := range expected {
+		rec := h.records[i]
+		var component string
+		rec.Attrs(func(a slog.Attr) bool {
+			if a.Key == "component" {
+				component = a.Value.String()
+			}
+			return true
+		})
+		if rec.Level != e.Level || component != e.Component || rec.Message != e.Message {
+			t.Errorf("Expected %v %q %q got %v %q %q", e.Level, e.Component, e.Message, rec.Level, component, rec.Message)
+		}
+	}
+
+	if args := strings.Join(stub.Calls()[0], " "); !strings.Contains(args, "-loglevel level+debug") {
+		t.Errorf("Expected level prefixed log level in %s", args)
+	}
+}
+
+func TestWithLevelPrefix(t *testing.T) {
+	tests := []struct {
+		Args     string
+		Expected string
+	}{
+		{Args: "-i in.mp4 out.mp4", Expected: "-loglevel level+info -i in.mp4 out.mp4"},
+		{Args: "-loglevel warning -i in.mp4 out.mp4", Expected: "-loglevel level+warning -i in.mp4 out.mp4"},
+		{Args: "-v repeat+level+error -i in.mp4 out.mp4", Expected: "-v repeat+level+error -i in.mp4 out.mp4"},
+	}
+
+	for _, test := range tests {
+		args := strings.Fields(test.Args)
+		if got := strings.Join(withLevelPrefix(args), " "); got != test.Expected {
+			t.Errorf("Expected %s got %s", test.Expected, got)
+		}
+		if got := strings.Join(args, " "); got != test.Args {
+			t.Errorf("Expected args left as %s got %s", test.Args, got)
+		}
+	}
+}
+
+func TestLogHandlerErrorLog(t *testing.T) {
+	stub := ffmpegtest.New(t, ffmpegtest.Script{
+		Stderr: []string{
+			"[info] Input #0, mov,mp4,m4a,3gp,3g2,mj2, from 'in.mp4':",
+			"[graph 0 input from stream 0:0 @ 0x7f9a5e00] [scale @ 0x7f9a5f00] [error] No such filter",
+		},
+		ExitCode: 1,
+	})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	r.LogHandler = &recordHandler{}
+	cmd, err := r.Command(nil, Input("in.mp4"), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+	var ferr *Error
+	if err := cmd.Run(); !errors.As(err, &ferr) {
+		t.Fatalf("Expected *Error got %v", err)
+	}
+
+	expected := []string{
+		"Input #0, mov,mp4,m4a,3gp,3g2,mj2, from 'in.mp4':",
+		"[graph 0 input from stream 0:0 @ 0x7f9a5e00] [scale @ 0x7f9a5f00] No such filter",
+	}
+	if !reflect.DeepEqual(ferr.Log, expected) {
+		t.Errorf("Expected log %q got %q", expected, ferr.Log)
+	}
+	if ferr.Message != expected[1] {
+		t.Errorf("Expected message %q got %q", expected[1], ferr.Message)
+	}
+}
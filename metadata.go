@@ -0,0 +1,70 @@
+package ffmpeg
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// MetadataScope selects the metadata -metadata and -map_metadata apply to:
+// that of the file itself, of some of its streams, of a chapter or of a
+// program.
+type MetadataScope struct {
+	typ    byte
+	stream StreamSpecifier
+	idx    int
+}
+
+// GlobalMetadataScope selects the metadata of the file itself, such as its
+// title.
+func GlobalMetadataScope() MetadataScope {
+	return MetadataScope{}
+}
+
+// StreamMetadataScope selects the metadata of the streams selected by
+// stream, such as their language.
+func StreamMetadataScope(stream StreamSpecifier) MetadataScope {
+	return MetadataScope{typ: 's', stream: stream}
+}
+
+// ChapterMetadataScope selects the metadata of the chapter at index idx,
+// counted from 0. The options given a negative idx return an error.
+func ChapterMetadataScope(idx int) MetadataScope {
+	return MetadataScope{typ: 'c', idx: idx}
+}
+
+// ProgramMetadataScope selects the metadata of the program at index idx,
+// counted from 0, rather than by its program ID. The options given a
+// negative idx return an error.
+func ProgramMetadataScope(idx int) MetadataScope {
+	return MetadataScope{typ: 'p', idx: idx}
+}
+
+// check returns an error if the scope, given to flag, is invalid.
+func (s MetadataScope) check(flag string) error {
+	switch {
+	case s.typ == 's':
+		return s.stream.check(flag)
+	case s.typ == 'c' && s.idx < 0:
+		return fmt.Errorf("unable to apply %s flag: negative chapter index %d", flag, s.idx)
+	case s.typ == 'p' && s.idx < 0:
+		return fmt.Errorf("unable to apply %s flag: negative program index %d", flag, s.idx)
+	}
+	return nil
+}
+
+// String returns the scope as the suffix of a flag, such as ":s:a:0" for
+// the first audio stream, ":c:1" for the second chapter or "" for the file
+// itself.
+func (s MetadataScope) String() string {
+	switch s.typ {
+	case 's':
+		if spec := s.stream.String(); spec != ":" {
+			return ":s" + spec
+		}
+		return ":s"
+	case 'c', 'p':
+		return ":" + string(s.typ) + ":" + strconv.Itoa(s.idx)
+	default:
+		return ""
+	}
+}
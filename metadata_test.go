@@ -0,0 +1,53 @@
+package ffmpeg
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMetadataScopeString(t *testing.T) {
+	tests := []struct {
+		Scope    MetadataScope
+		Expected string
+	}{
+		{Scope: GlobalMetadataScope(), Expected: ""},
+		{Scope: StreamMetadataScope(AllStreamSpecifier()), Expected: ":s"},
+		{Scope: StreamMetadataScope(AudioStreamSpecifier(0)), Expected: ":s:a:0"},
+		{Scope: StreamMetadataScope(SubtitleStreamSpecifier(-1)), Expected: ":s:s"},
+		{Scope: StreamMetadataScope(VideoStreamSpecifier(-1).InProgram(1)), Expected: ":s:p:1:v"},
+		{Scope: StreamMetadataScope(StreamIndexSpecifier(2)), Expected: ":s:2"},
+		{Scope: ChapterMetadataScope(0), Expected: ":c:0"},
+		{Scope: ProgramMetadataScope(3), Expected: ":p:3"},
+	}
+
+	for _, test := range tests {
+		if got := test.Scope.String(); got != test.Expected {
+			t.Errorf("Expected %q got %q", test.Expected, got)
+		}
+	}
+}
+
+func TestMetadataScopeNegative(t *testing.T) {
+	input := &File{typ: fileTypeInput}
+	tests := []struct {
+		Option FileOption
+		Err    string
+	}{
+		{Option: WithMetadata(ChapterMetadataScope(-1), "title", "x"), Err: "negative chapter index -1"},
+		{Option: WithMetadata(ProgramMetadataScope(-2), "title", "x"), Err: "negative program index -2"},
+		{Option: WithMetadata(StreamMetadataScope(StreamIndexSpecifier(-1)), "title", "x"), Err: "negative stream index -1"},
+		{Option: WithMapMetadata(input, ChapterMetadataScope(-1), GlobalMetadataScope()), Err: "negative chapter index -1"},
+		{Option: WithMapMetadata(input, GlobalMetadataScope(), ProgramMetadataScope(-1)), Err: "negative program index -1"},
+	}
+
+	for i, test := range tests {
+		f := &File{typ: fileTypeOutput}
+		err := test.Option(f)
+		if err == nil || !strings.Contains(err.Error(), test.Err) {
+			t.Errorf("%d: Expected error %q got %v", i, test.Err, err)
+		}
+		if len(f.options) != 0 || len(f.metadataMaps) != 0 {
+			t.Errorf("%d: Expected no flags got %v", i, f.options)
+		}
+	}
+}
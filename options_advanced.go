@@ -1,13 +1,137 @@
 package ffmpeg
 
+import (
+	"fmt"
+	"strings"
+)
+
 // FLAG                    SPEC   ARGS                 AFFECTS         IMPL
+// map                     false  [[-]input[:stream]]  [output]        [X]
 // re                      false  []                   [input]         [ ]
-// accurate_seek           false  []                   [input]         [ ]
+// accurate_seek           false  []                   [input]         [X]
 // seek_timestamp          false  []                   [input]         [ ]
 // thread_queue_size       false  [size]               [input]         [ ]
 // discard                 false  []                   [input]         [ ]
 // tag                     true   [codec_tag]          [input output]  [ ]
-// map_chapters            false  [input_file_index]   [output]        [ ]
+// map_chapters            false  [input_file_index]   [output]        [X]
 // enc_time_base           true   [timebase]           [output]        [ ]
 // bsf                     true   [bitstream_filters]  [output]        [ ]
 // max_muxing_queue_size   false  [packets]            [output]        [ ]
+
+// WithAccurateSeek sets whether the frames decoded between the keyframe an
+// input file is seeked to and the position set with WithSeek are dropped.
+// It is enabled by default, and has no effect on streams that are copied.
+func WithAccurateSeek(accurate bool) FileOption {
+	return func(f *File) error {
+		if f.typ != fileTypeInput {
+			return fmt.Errorf("unable to apply -accurate_seek flag: not input file")
+		}
+		if accurate {
+			f.options = append(f.options, "-accurate_seek")
+		} else {
+			f.options = append(f.options, "-noaccurate_seek")
+		}
+		return nil
+	}
+}
+
+// WithMap selects the streams of input selected by stream for an output
+// file, instead of the streams ffmpeg selects by default. It can be given
+// several times, and the streams are written in order.
+//
+// input must be one of the input files passed to Command, which renders
+// it as its index.
+func WithMap(input *File, stream StreamSpecifier) FileOption {
+	return withMap(streamMap{input: input, stream: stream})
+}
+
+// WithOptionalMap is like WithMap, but ffmpeg ignores it instead of failing
+// when input has no stream selected by stream.
+func WithOptionalMap(input *File, stream StreamSpecifier) FileOption {
+	return withMap(streamMap{input: input, stream: stream, optional: true})
+}
+
+// WithNegativeMap removes the streams of input selected by stream from
+// those selected by the maps preceding it, such as all the subtitle
+// streams after WithMap(input, AllStreamSpecifier()).
+func WithNegativeMap(input *File, stream StreamSpecifier) FileOption {
+	return withMap(streamMap{input: input, stream: stream, negative: true})
+}
+
+// WithMapLabel selects the output of a filtergraph labelled label, such as
+// "out" for the output "[out]", for an output file.
+func WithMapLabel(label string) FileOption {
+	return func(f *File) error {
+		label = strings.TrimSuffix(strings.TrimPrefix(label, "["), "]")
+		if label == "" || strings.ContainsAny(label, "[]") {
+			return fmt.Errorf("unable to apply -map flag: invalid filtergraph label %q", label)
+		}
+		return withMap(streamMap{label: label})(f)
+	}
+}
+
+func withMap(m streamMap) FileOption {
+	return func(f *File) error {
+		if f.typ != fileTypeOutput {
+			return fmt.Errorf("unable to apply -map flag: not output file")
+		}
+		if m.label == "" && (m.input == nil || m.input.typ != fileTypeInput) {
+			return fmt.Errorf("unable to apply -map flag: streams not mapped from an input file")
+		}
+		if err := m.stream.check("-map"); err != nil {
+			return err
+		}
+		f.maps = append(f.maps, m)
+		return nil
+	}
+}
+
+// WithMapMetadata copies the metadata of input in the scope from to the
+// scope to of an output file, such as the language of the first audio
+// stream of input to the second audio stream of the output. It replaces
+// the metadata ffmpeg copies by default to that scope.
+//
+// If input is nil, no metadata is copied to the scope to, stripping that
+// copied by default; GlobalMetadataScope strips all of it. from must then
+// be GlobalMetadataScope.
+//
+// input must otherwise be one of the input files passed to Command, which
+// renders it as its index.
+func WithMapMetadata(input *File, from, to MetadataScope) FileOption {
+	return func(f *File) error {
+		flag := "-map_metadata" + to.String()
+		if err := from.check(flag); err != nil {
+			return err
+		}
+		if err := to.check(flag); err != nil {
+			return err
+		}
+		if input == nil && from != GlobalMetadataScope() {
+			return fmt.Errorf("unable to apply %s flag: metadata%s not copied from an input file", flag, from)
+		}
+		return withMetadataMap(metadataMap{flag: flag, input: input, from: from})(f)
+	}
+}
+
+// WithMapChapters copies the chapters of input to an output file, instead
+// of those of the first input file with chapters. If input is nil, no
+// chapters are copied.
+//
+// input must otherwise be one of the input files passed to Command, which
+// renders it as its index.
+func WithMapChapters(input *File) FileOption {
+	return withMetadataMap(metadataMap{flag: "-map_chapters", input: input})
+}
+
+func withMetadataMap(m metadataMap) FileOption {
+	return func(f *File) error {
+		if f.typ != fileTypeOutput {
+			return fmt.Errorf("unable to apply %s flag: not output file", m.flag)
+		}
+		if m.input != nil && m.input.typ != fileTypeInput {
+			return fmt.Errorf("unable to apply %s flag: not copied from an input file", m.flag)
+		}
+		f.metadataMaps = append(f.metadataMaps, m)
+		return nil
+	}
+}
@@ -1,13 +1,111 @@
 package ffmpeg
 
+import (
+	"fmt"
+	"strconv"
+)
+
 // FLAG              SPEC   ARGS           AFFECTS         IMPL
-// guess_layout_max  false  [channels]     [input]         [ ]
-// ar                true   [freq]         [input output]  [ ]
-// ac                true   [channels]     [input output]  [ ]
+// guess_layout_max  false  [channels]     [input]         [X]
+// ar                true   [freq]         [input output]  [X]
+// ac                true   [channels]     [input output]  [X]
+// channel_layout    true   [layout]       [input output]  [X]
 // acodec            false  [codec]        [input output]  [ ]
 // aframes           false  [number]       [output]        [ ]
 // aq                false  [q]            [output]        [ ]
 // an                false  []             [output]        [ ]
-// sample_fmt        true   [sample_fmt]   [output]        [ ]
+// sample_fmt        true   [sample_fmt]   [output]        [X]
 // af                false  [filtergraph]  [output]        [ ]
 // atag              false  [fourcc/tag]   [output]        [ ]
+
+// WithGuessLayoutMax sets the largest number of channels of input audio
+// streams whose unknown channel layout ffmpeg guesses. 0 disables guessing.
+func WithGuessLayoutMax(channels int) FileOption {
+	return func(f *File) error {
+		if f.typ != fileTypeInput {
+			return fmt.Errorf("unable to apply -guess_layout_max flag: not input file")
+		}
+		if channels < 0 {
+			return fmt.Errorf("unable to apply -guess_layout_max flag: negative number of channels %d", channels)
+		}
+		f.options = append(f.options, []string{"-guess_layout_max", strconv.Itoa(channels)}...)
+		return nil
+	}
+}
+
+// WithSampleRate sets the sample rate, in Hz, of one or more audio streams
+func WithSampleRate(stream StreamSpecifier, hz int) FileOption {
+	return func(f *File) error {
+		flag := "-ar" + stream.String()
+		if err := stream.check(flag); err != nil {
+			return err
+		}
+		if !streamTypeMatches(stream.Stream, StreamTypeAudio) {
+			return fmt.Errorf("unable to apply %s flag: not audio streams", flag)
+		}
+		if hz <= 0 {
+			return fmt.Errorf("unable to apply %s flag: invalid sample rate %d", flag, hz)
+		}
+		f.options = append(f.options, []string{flag, strconv.Itoa(hz)}...)
+		return nil
+	}
+}
+
+// WithChannels sets the number of channels of one or more audio streams
+func WithChannels(stream StreamSpecifier, n int) FileOption {
+	return func(f *File) error {
+		flag := "-ac" + stream.String()
+		if err := stream.check(flag); err != nil {
+			return err
+		}
+		if !streamTypeMatches(stream.Stream, StreamTypeAudio) {
+			return fmt.Errorf("unable to apply %s flag: not audio streams", flag)
+		}
+		if n <= 0 {
+			return fmt.Errorf("unable to apply %s flag: invalid number of channels %d", flag, n)
+		}
+		f.options = append(f.options, []string{flag, strconv.Itoa(n)}...)
+		return nil
+	}
+}
+
+// WithChannelLayout sets the channel layout of one or more audio streams,
+// which also sets their number of channels.
+func WithChannelLayout(stream StreamSpecifier, cl ChannelLayout) FileOption {
+	return func(f *File) error {
+		flag := "-channel_layout" + stream.String()
+		if err := stream.check(flag); err != nil {
+			return err
+		}
+		if !streamTypeMatches(stream.Stream, StreamTypeAudio) {
+			return fmt.Errorf("unable to apply %s flag: not audio streams", flag)
+		}
+		if cl.String() == "" {
+			return fmt.Errorf("unable to apply %s flag: unknown channel layout %d", flag, cl)
+		}
+		f.options = append(f.options, []string{flag, cl.String()}...)
+		return nil
+	}
+}
+
+// WithSampleFormat sets the sample format of one or more audio streams of
+// an output file.
+func WithSampleFormat(stream StreamSpecifier, sf SampleFormat) FileOption {
+	return func(f *File) error {
+		flag := "-sample_fmt" + stream.String()
+		if err := stream.check(flag); err != nil {
+			return err
+		}
+		if f.typ != fileTypeOutput {
+			return fmt.Errorf("unable to apply %s flag: not output file", flag)
+		}
+		if !streamTypeMatches(stream.Stream, StreamTypeAudio) {
+			return fmt.Errorf("unable to apply %s flag: not audio streams", flag)
+		}
+		if sf.String() == "" {
+			return fmt.Errorf("unable to apply %s flag: unknown sample format %d", flag, sf)
+		}
+		f.options = append(f.options, []string{flag, sf.String()}...)
+		return nil
+	}
+}
@@ -2,9 +2,12 @@ package ffmpeg
 
 import (
 	"fmt"
+	"log/slog"
 	"strconv"
 	"strings"
 	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
 )
 
 // FLAG                    SPEC   ARGS           AFFECTS   IMPL
@@ -14,7 +17,7 @@ import (
 // n                       false  []             [global]  [X]
 // filter_threads          false  [nb_threads]   [global]  [X]
 // stats                   false  []             [global]  [ ]
-// progress                false  [url]          [global]  [ ]
+// progress                false  [url]          [global]  [X]
 // debug_ts                false  []             [global]  [ ]
 // qphist                  false  []             [global]  [ ]
 // benchmark               false  []             [global]  [ ]
@@ -38,14 +41,27 @@ type GlobalOption func() ([]string, error)
 type GlobalOptions []GlobalOption
 
 // Flags generates the ffmpeg flags to be applied
+//
+// Options that fail, such as WithTimelimit with a duration that is not
+// positive, add no flags. Command reports their errors.
 func (g GlobalOptions) Flags() []string {
-	// TODO: capture potential errors and return *mutlierror.Error
+	f, _ := g.flags()
+	return f
+}
+
+// flags generates the ffmpeg flags to be applied, along with the errors
+// of the options that failed.
+func (g GlobalOptions) flags() ([]string, error) {
 	var f []string
+	var errs *multierror.Error
 	for _, opt := range g {
-		gf, _ := opt()
+		gf, err := opt()
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
 		f = append(f, gf...)
 	}
-	return f
+	return f, errs.ErrorOrNil()
 }
 
 // WithLogLevel sets the logging level used by ffmpeg
@@ -94,9 +110,82 @@ func (l LogLevel) String() string {
 	}
 }
 
+// Level returns the slog level matching the log level, so that LogLevel
+// implements slog.Leveler.
+func (l LogLevel) Level() slog.Level {
+	switch l {
+	case LogLevelPanic, LogLevelFatal:
+		return slog.LevelError + 4
+	case LogLevelError:
+		return slog.LevelError
+	case LogLevelWarning:
+		return slog.LevelWarn
+	case LogLevelInfo:
+		return slog.LevelInfo
+	case LogLevelVerbose:
+		return slog.LevelDebug + 2
+	case LogLevelDebug:
+		return slog.LevelDebug
+	case LogLevelTrace:
+		return slog.LevelDebug - 4
+	default:
+		return slog.LevelError + 8
+	}
+}
+
+func parseLogLevel(s string) (LogLevel, bool) {
+	for l := LogLevelQuiet; l <= LogLevelTrace; l++ {
+		if l.String() == s {
+			return l, true
+		}
+	}
+	return LogLevelInfo, false
+}
+
+// AllLogLevels returns all the LogLevel constants, from the quietest.
+func AllLogLevels() []LogLevel {
+	return []LogLevel{LogLevelQuiet, LogLevelPanic, LogLevelFatal, LogLevelError, LogLevelWarning, LogLevelInfo, LogLevelVerbose, LogLevelDebug, LogLevelTrace}
+}
+
+// ParseLogLevel returns the LogLevel named name, such as "warning".
+func ParseLogLevel(name string) (LogLevel, error) {
+	if l, ok := parseLogLevel(name); ok {
+		return l, nil
+	}
+	var names []string
+	for _, l := range AllLogLevels() {
+		names = append(names, l.String())
+	}
+	return LogLevelInfo, unknownName("log level", name, names)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l LogLevel) MarshalText() ([]byte, error) {
+	if l < LogLevelQuiet || l > LogLevelTrace || l == 0 {
+		return nil, invalidValue("log level", int(l))
+	}
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *LogLevel) UnmarshalText(text []byte) error {
+	return l.Set(string(text))
+}
+
+// Set implements flag.Value.
+func (l *LogLevel) Set(name string) error {
+	parsed, err := ParseLogLevel(name)
+	if err != nil {
+		return err
+	}
+	*l = parsed
+	return nil
+}
+
 // WithOpenCLOptions sets OpenCL environment options
 //
-// This option is only available when FFmpeg has been compiled with "--enable-opencl"
+// This option is only available when FFmpeg has been compiled with "--enable-opencl",
+// which is checked by runners with Capabilities set
 func WithOpenCLOptions(opts map[string]string) GlobalOption {
 	create := func(opts map[string]string) string {
 		var f []string
@@ -139,19 +228,18 @@ func WithNumFilterThreads(num int) GlobalOption {
 
 // WithTimelimit sets the timelimit duration on ffmpeg.
 //
-// Exit after ffmpeg has been running for duration seconds.
-func WithTimelimit(dur time.Duration) GlobalOption {
-	create := func(dur time.Duration) string {
-		matches := regexpDuration.FindAllStringSubmatch(dur.String(), -1)
-
-		hour, _ := strconv.ParseInt(matches[0][2], 10, 32)
-		minute, _ := strconv.ParseInt(matches[0][4], 10, 32)
-		seconds, _ := strconv.ParseFloat(matches[0][6], 32)
-
-		return fmt.Sprintf("%02d:%02d:%f", hour, minute, seconds)
-	}
+// Exit after ffmpeg has been running for duration seconds. ffmpeg only
+// takes whole seconds, so the duration is rounded up.
+func WithTimelimit(duration time.Duration) GlobalOption {
+	dur := Position(duration)
 	return func() ([]string, error) {
-		return []string{"-timelimit", create(dur)}, nil
+		if err := checkPosition("timelimit", dur); err != nil {
+			return nil, err
+		}
+		if dur <= 0 {
+			return nil, fmt.Errorf("unable to apply -timelimit flag: invalid duration %s", dur)
+		}
+		return []string{"-timelimit", strconv.FormatInt(dur.seconds(), 10)}, nil
 	}
 }
 
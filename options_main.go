@@ -2,14 +2,14 @@ package ffmpeg
 
 import (
 	"fmt"
-	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // FLAG             SPEC   ARGS                              AFFECTS        IMPL
 // stream_loop      false  [number]                         [input]         [X]
-// itsoffset        false  [offset]                         [input]         [ ]
+// itsoffset        false  [offset]                         [input]         [X]
 // dump_attachment  true   [filename]                       [input]         [ ]
 // muxdelay         false  [seconds]                        [input]         [ ]
 // muxpreload       false  [seconds]                        [input]         [ ]
@@ -17,12 +17,13 @@ import (
 // c                true   [codec]                          [input output]  [X]
 // codec            true   [codec]                          [input output]  [X]
 // t                false  [duration]                       [input output]  [X]
-// ss               false  [position]                       [input output]  [ ]
-// sseof            false  [position]                       [input output]  [ ]
-// to               false  [position]                       [output]        [ ]
+// ss               false  [position]                       [input output]  [X]
+// sseof            false  [position]                       [input]         [X]
+// to               false  [position]                       [input output]  [X]
 // fs               false  [limit_size]                     [output]        [ ]
 // timestamp        false  [date]                           [output]        [ ]
-// metadata         true   [key=value]                      [output]        [ ]
+// metadata         true   [key=value]                      [output]        [X]
+// map_metadata     true   [infile[:metadata_spec]]         [output]        [X]
 // disposition      true   [value]                          [output]        [ ]
 // target           false  [type]                           [output]        [ ]
 // dframes          false  [number]                         [output]        [ ]
@@ -57,8 +58,15 @@ func WithStreamLoop(loop int) FileOption {
 // The format is normally auto detected for input files and guessed
 // from the file extension for output files, so this option is
 // not needed in most cases.
+//
+// Formats ffmpeg can not write, such as FileFormatAvfoundation, can not
+// be forced for output files.
 func WithFormat(ff FileFormat) FileOption {
 	return func(f *File) error {
+		if f.typ == fileTypeOutput && !ff.CanMux() {
+			return fmt.Errorf("unable to apply -f flag: format %s can not be written", ff)
+		}
+		f.format = ff.String()
 		f.options = append(f.options, []string{"-f", ff.String()}...)
 		return nil
 	}
@@ -68,27 +76,168 @@ func WithFormat(ff FileFormat) FileOption {
 // or a decoder (when used before an input file) for one or more streams
 func WithCodec(stream StreamSpecifier, codec Codec) FileOption {
 	return func(f *File) error {
-		f.options = append(f.options, []string{"-c" + stream.String(), codec.String()}...)
+		flag := "-c" + stream.String()
+		if err := stream.check(flag); err != nil {
+			return err
+		}
+		f.options = append(f.options, []string{flag, codec.String()}...)
 		return nil
 	}
 }
 
-var regexpDuration = regexp.MustCompile(`((\d+)h)?((\d+)m)?(([0-9.]+)s)?`)
+// WithEncoder selects the encoder of one or more streams of an output file,
+// or EncoderCopy to copy them without re-encoding
+func WithEncoder(stream StreamSpecifier, enc Encoder) FileOption {
+	return func(f *File) error {
+		flag := "-c" + stream.String()
+		if err := stream.check(flag); err != nil {
+			return err
+		}
+		if f.typ != fileTypeOutput {
+			return fmt.Errorf("unable to apply %s flag: encoder %s set on input file", flag, enc)
+		}
+		if enc.String() == "" {
+			return fmt.Errorf("unable to apply %s flag: unknown encoder %d", flag, enc)
+		}
+		if enc != EncoderCopy && !streamTypeMatches(stream.Stream, enc.MediaType()) {
+			return fmt.Errorf("unable to apply %s flag: encoder %s does not encode %s streams", flag, enc, stream.Stream)
+		}
+		if f.encoders == nil {
+			f.encoders = map[string]Encoder{}
+		}
+		f.encoders[stream.String()] = enc
+		f.options = append(f.options, []string{flag, enc.String()}...)
+		return nil
+	}
+}
+
+// WithDecoder selects the decoder of one or more streams of an input file
+func WithDecoder(stream StreamSpecifier, dec Decoder) FileOption {
+	return func(f *File) error {
+		flag := "-c" + stream.String()
+		if err := stream.check(flag); err != nil {
+			return err
+		}
+		if f.typ != fileTypeInput {
+			return fmt.Errorf("unable to apply %s flag: decoder %s set on output file", flag, dec)
+		}
+		if dec.String() == "" {
+			return fmt.Errorf("unable to apply %s flag: unknown decoder %d", flag, dec)
+		}
+		if !streamTypeMatches(stream.Stream, dec.MediaType()) {
+			return fmt.Errorf("unable to apply %s flag: decoder %s does not decode %s streams", flag, dec, stream.Stream)
+		}
+		f.options = append(f.options, []string{flag, dec.String()}...)
+		return nil
+	}
+}
+
+// streamTypeMatches reports whether streams selected by their type st can
+// be of type typ.
+func streamTypeMatches(st, typ StreamType) bool {
+	return st == StreamTypeAll || st == typ || (st == StreamTypeVideoNoAttachedPic && typ == StreamTypeVideo)
+}
 
 // WithDuration when used as an input option limits the duration of the data read from the input file
 // and when used as an output option limits stops writing the ouput after its duration reaches duration.
-func WithDuration(dur time.Duration) FileOption {
-	create := func(dur time.Duration) string {
-		matches := regexpDuration.FindAllStringSubmatch(dur.String(), -1)
+func WithDuration(duration time.Duration) FileOption {
+	dur := Position(duration)
+	return func(f *File) error {
+		if err := checkPosition("t", dur); err != nil {
+			return err
+		}
+		if dur < 0 {
+			return fmt.Errorf("unable to apply -t flag: negative duration %s", dur)
+		}
+		f.setPosition("t", dur)
+		f.options = append(f.options, []string{"-t", dur.String()}...)
+		return nil
+	}
+}
 
-		hour, _ := strconv.ParseInt(matches[0][2], 10, 32)
-		minute, _ := strconv.ParseInt(matches[0][4], 10, 32)
-		seconds, _ := strconv.ParseFloat(matches[0][6], 32)
+// WithSeek seeks to pos before reading an input file or writing an
+// output file.
+//
+// On an input file ffmpeg seeks in the file itself, which is fast. It can
+// only seek to keyframes, but unless disabled with WithAccurateSeek the
+// frames decoded between the keyframe and pos are then dropped, so
+// transcoded streams still start at pos; copied streams start at the
+// keyframe. The timestamps of the input then start at 0, which positions
+// set on its outputs are relative to.
+//
+// On an output file ffmpeg decodes and drops everything before pos, which
+// is slow but accurate to the frame, even for streams it copies.
+func WithSeek(position time.Duration) FileOption {
+	pos := Position(position)
+	return func(f *File) error {
+		if err := checkPosition("ss", pos); err != nil {
+			return err
+		}
+		if pos < 0 {
+			return fmt.Errorf("unable to apply -ss flag: negative position %s", pos)
+		}
+		f.setPosition("ss", pos)
+		f.options = append(f.options, []string{"-ss", pos.String()}...)
+		return nil
+	}
+}
+
+// WithSeekFromEnd seeks an input file to offset from its end, which must be
+// negative, such as -10 * time.Second for its last 10 seconds. It seeks
+// like WithSeek does on input files.
+func WithSeekFromEnd(offsetFromEnd time.Duration) FileOption {
+	offset := Position(offsetFromEnd)
+	return func(f *File) error {
+		if f.typ != fileTypeInput {
+			return fmt.Errorf("unable to apply -sseof flag: not input file")
+		}
+		if err := checkPosition("sseof", offset); err != nil {
+			return err
+		}
+		if offset >= 0 {
+			return fmt.Errorf("unable to apply -sseof flag: offset %s is not negative", offset)
+		}
+		f.setPosition("sseof", offset)
+		f.options = append(f.options, []string{"-sseof", offset.String()}...)
+		return nil
+	}
+}
 
-		return fmt.Sprintf("%02d:%02d:%f", hour, minute, seconds)
+// WithEndPosition stops reading an input file or writing an output file at
+// pos. Positions are those of the file, so for an output file they are
+// relative to where its inputs were seeked to.
+//
+// It can not be used with WithDuration on the same file. ffmpeg supports
+// it on input files since 4.0, which Command checks when the runner has
+// Capabilities.
+func WithEndPosition(position time.Duration) FileOption {
+	pos := Position(position)
+	return func(f *File) error {
+		if err := checkPosition("to", pos); err != nil {
+			return err
+		}
+		if pos < 0 {
+			return fmt.Errorf("unable to apply -to flag: negative position %s", pos)
+		}
+		f.setPosition("to", pos)
+		f.options = append(f.options, []string{"-to", pos.String()}...)
+		return nil
 	}
+}
+
+// WithTimeOffset sets the time offset of an input file, which is added to
+// its timestamps. A positive offset delays the input, a negative one
+// advances it.
+func WithTimeOffset(timeOffset time.Duration) FileOption {
+	offset := Position(timeOffset)
 	return func(f *File) error {
-		f.options = append(f.options, []string{"-t", create(dur)}...)
+		if f.typ != fileTypeInput {
+			return fmt.Errorf("unable to apply -itsoffset flag: not input file")
+		}
+		if err := checkPosition("itsoffset", offset); err != nil {
+			return err
+		}
+		f.options = append(f.options, []string{"-itsoffset", offset.String()}...)
 		return nil
 	}
 }
@@ -115,3 +264,34 @@ func WithTimestamp(date time.Time) FileOption {
 		return nil
 	}
 }
+
+// WithMetadata sets the metadata tag key to value in the scope of an output
+// file, such as its title or the language of one of its streams. An empty
+// value removes the tag, such as one copied from an input file.
+//
+// key and value are passed to ffmpeg as they are, as a single argument, so
+// need no escaping; Cmd.String quotes them for a shell. ffmpeg splits them
+// at the first "=", so key can not contain one.
+//
+// Newlines in value are kept, and written as the output format stores
+// them; formats whose tags are a single line may cut or replace them.
+// Arguments can not contain NUL, so neither key nor value can.
+func WithMetadata(scope MetadataScope, key, value string) FileOption {
+	return func(f *File) error {
+		flag := "-metadata" + scope.String()
+		if err := scope.check(flag); err != nil {
+			return err
+		}
+		if f.typ != fileTypeOutput {
+			return fmt.Errorf("unable to apply %s flag: not output file", flag)
+		}
+		if key == "" || strings.ContainsAny(key, "=\x00") {
+			return fmt.Errorf("unable to apply %s flag: invalid metadata key %q", flag, key)
+		}
+		if strings.ContainsRune(value, 0) {
+			return fmt.Errorf("unable to apply %s flag: invalid metadata value %q", flag, value)
+		}
+		f.options = append(f.options, []string{flag, key + "=" + value}...)
+		return nil
+	}
+}
@@ -1,6 +1,7 @@
 package ffmpeg
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -11,21 +12,49 @@ func TestWithDuration(t *testing.T) {
 		Duration string
 		Expected string
 	}{
-		{Duration: "1h20m4s", Expected: "01:20:4.000000"},
-		{Duration: "1h20m4s53ms", Expected: "01:20:4.053000"},
-		{Duration: "4s100ms", Expected: "00:00:4.100000"},
+		{Duration: "1h20m4s", Expected: "01:20:04"},
+		{Duration: "1h20m4s53ms", Expected: "01:20:04.053"},
+		{Duration: "4s100ms", Expected: "00:00:04.1"},
+		{Duration: "500ms", Expected: "00:00:00.5"},
+		{Duration: "26h", Expected: "26:00:00"},
+		{Duration: "-1s", Expected: ""},
 	}
 
 	for _, test := range tests {
 		f := &File{}
 		d, _ := time.ParseDuration(test.Duration)
-		opt := WithDuration(d)
-		if err := opt(f); err != nil {
-			t.Errorf("unable to apply option: %v", err)
+		err := WithDuration(d)(f)
+		if (err != nil) != (test.Expected == "") {
+			t.Errorf("%s: unexpected error %v", test.Duration, err)
+			continue
 		}
 
-		if f.options[1] != test.Expected {
-			t.Errorf("Expected %s got %s", test.Expected, f.options[1])
+		if got := strings.Join(f.options, " "); test.Expected != "" && got != "-t "+test.Expected {
+			t.Errorf("Expected -t %s got %s", test.Expected, got)
+		}
+	}
+}
+
+func TestWithTimelimit(t *testing.T) {
+	tests := []struct {
+		Duration time.Duration
+		Expected []string
+		Err      bool
+	}{
+		{Duration: 90 * time.Second, Expected: []string{"-timelimit", "90"}},
+		{Duration: 1500 * time.Millisecond, Expected: []string{"-timelimit", "2"}},
+		{Duration: 25 * time.Hour, Expected: []string{"-timelimit", "90000"}},
+		{Duration: 0, Err: true},
+	}
+
+	for _, test := range tests {
+		got, err := WithTimelimit(test.Duration)()
+		if test.Err != (err != nil) {
+			t.Errorf("%s: Expected error %v got %v", test.Duration, test.Err, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.Expected) {
+			t.Errorf("Expected %q got %q", test.Expected, got)
 		}
 	}
 }
@@ -54,3 +83,272 @@ func TestWithCodec(t *testing.T) {
 		}
 	}
 }
+
+func TestWithEncoder(t *testing.T) {
+	tests := []struct {
+		Stream   StreamSpecifier
+		Encoder  Encoder
+		Expected string
+		Err      bool
+	}{
+		{Stream: VideoStreamSpecifier(0), Encoder: EncoderLibx264, Expected: "-c:v:0 libx264"},
+		{Stream: AudioStreamSpecifier(-1), Encoder: EncoderAacAt, Expected: "-c:a aac_at"},
+		{Stream: AllStreamSpecifier(), Encoder: EncoderCopy, Expected: "-c: copy"},
+		{Stream: StreamIndexSpecifier(1), Encoder: EncoderLibx264, Expected: "-c:1 libx264"},
+		{Stream: AudioStreamSpecifier(0), Encoder: EncoderLibx264, Err: true},
+		{Stream: AudioStreamSpecifier(0), Encoder: Encoder(-1), Err: true},
+	}
+
+	for _, test := range tests {
+		f := &File{typ: fileTypeOutput}
+		err := WithEncoder(test.Stream, test.Encoder)(f)
+		if test.Err {
+			if err == nil {
+				t.Errorf("%s: Expected error got %v", test.Encoder, f.options)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unable to apply option: %v", err)
+		}
+		if strings.Join(f.options, " ") != test.Expected {
+			t.Errorf("Expected %s got %s", test.Expected, strings.Join(f.options, " "))
+		}
+	}
+
+	if err := WithEncoder(AllStreamSpecifier(), EncoderCopy)(&File{typ: fileTypeInput}); err == nil {
+		t.Errorf("Expected error applying encoder to input file")
+	}
+}
+
+func TestWithDecoder(t *testing.T) {
+	f := &File{typ: fileTypeInput}
+	if err := WithDecoder(AudioStreamSpecifier(-1), DecoderAacFixed)(f); err != nil {
+		t.Errorf("unable to apply option: %v", err)
+	}
+	if expected := "-c:a aac_fixed"; strings.Join(f.options, " ") != expected {
+		t.Errorf("Expected %s got %s", expected, strings.Join(f.options, " "))
+	}
+
+	if err := WithDecoder(VideoStreamSpecifier(-1), DecoderAacFixed)(&File{typ: fileTypeInput}); err == nil {
+		t.Errorf("Expected error applying audio decoder to video streams")
+	}
+	if err := WithDecoder(AudioStreamSpecifier(-1), DecoderAac)(&File{typ: fileTypeOutput}); err == nil {
+		t.Errorf("Expected error applying decoder to output file")
+	}
+}
+
+func TestWithFormat(t *testing.T) {
+	tests := []struct {
+		Type   fileType
+		Format FileFormat
+		Err    bool
+	}{
+		{Type: fileTypeOutput, Format: FileFormatMatroska},
+		{Type: fileTypeOutput, Format: FileFormatMpegts},
+		{Type: fileTypeOutput, Format: FileFormatAvfoundation, Err: true},
+		{Type: fileTypeInput, Format: FileFormatAvfoundation},
+		{Type: fileTypeInput, Format: FileFormatMatroska},
+	}
+
+	for _, test := range tests {
+		f := &File{typ: test.Type}
+		err := WithFormat(test.Format)(f)
+		if test.Err != (err != nil) {
+			t.Errorf("%s: Expected error %v got %v", test.Format, test.Err, err)
+			continue
+		}
+		if !test.Err && f.format != test.Format.String() {
+			t.Errorf("Expected format %s got %s", test.Format, f.format)
+		}
+	}
+}
+
+func TestWithPixelFormat(t *testing.T) {
+	tests := []struct {
+		Type        fileType
+		PixelFormat PixelFormat
+		Expected    string
+		Err         bool
+	}{
+		{Type: fileTypeOutput, PixelFormat: PixelFormatYuv420P10Le, Expected: "-pix_fmt:v:0 yuv420p10le"},
+		{Type: fileTypeOutput, PixelFormat: PixelFormatVideotoolboxVld, Expected: "-pix_fmt:v:0 videotoolbox_vld"},
+		{Type: fileTypeOutput, PixelFormat: PixelFormatBayerRggb8, Err: true},
+		{Type: fileTypeOutput, PixelFormat: PixelFormat(-1), Err: true},
+		{Type: fileTypeInput, PixelFormat: PixelFormatBayerRggb8, Expected: "-pix_fmt:v:0 bayer_rggb8"},
+	}
+
+	for _, test := range tests {
+		f := &File{typ: test.Type}
+		err := WithPixelFormat(VideoStreamSpecifier(0), test.PixelFormat)(f)
+		if test.Err != (err != nil) {
+			t.Errorf("%s: Expected error %v got %v", test.PixelFormat, test.Err, err)
+			continue
+		}
+		if got := strings.Join(f.options, " "); got != test.Expected {
+			t.Errorf("Expected %s got %s", test.Expected, got)
+		}
+	}
+}
+
+func TestAudioOptions(t *testing.T) {
+	tests := []struct {
+		Type     fileType
+		Option   FileOption
+		Expected string
+		Err      bool
+	}{
+		{Type: fileTypeOutput, Option: WithSampleFormat(AudioStreamSpecifier(0), SampleFormatS16), Expected: "-sample_fmt:a:0 s16"},
+		{Type: fileTypeOutput, Option: WithSampleFormat(AllStreamSpecifier(), SampleFormatFltp), Expected: "-sample_fmt: fltp"},
+		{Type: fileTypeInput, Option: WithSampleFormat(AudioStreamSpecifier(0), SampleFormatS16), Err: true},
+		{Type: fileTypeOutput, Option: WithSampleFormat(VideoStreamSpecifier(0), SampleFormatS16), Err: true},
+		{Type: fileTypeOutput, Option: WithSampleFormat(AudioStreamSpecifier(0), SampleFormat(-1)), Err: true},
+		{Type: fileTypeInput, Option: WithSampleRate(AudioStreamSpecifier(-1), 48000), Expected: "-ar:a 48000"},
+		{Type: fileTypeOutput, Option: WithSampleRate(AudioStreamSpecifier(1), 44100), Expected: "-ar:a:1 44100"},
+		{Type: fileTypeOutput, Option: WithSampleRate(AudioStreamSpecifier(0), 0), Err: true},
+		{Type: fileTypeOutput, Option: WithChannels(AudioStreamSpecifier(0), 2), Expected: "-ac:a:0 2"},
+		{Type: fileTypeOutput, Option: WithChannels(SubtitleStreamSpecifier(0), 2), Err: true},
+		{Type: fileTypeOutput, Option: WithChannels(AudioStreamSpecifier(0), -1), Err: true},
+		{Type: fileTypeInput, Option: WithChannelLayout(AudioStreamSpecifier(0), ChannelLayout5Point1Side), Expected: "-channel_layout:a:0 5.1(side)"},
+		{Type: fileTypeOutput, Option: WithChannelLayout(AudioStreamSpecifier(0), ChannelLayout(-1)), Err: true},
+		{Type: fileTypeInput, Option: WithGuessLayoutMax(2), Expected: "-guess_layout_max 2"},
+		{Type: fileTypeOutput, Option: WithGuessLayoutMax(2), Err: true},
+	}
+
+	for i, test := range tests {
+		f := &File{typ: test.Type}
+		err := test.Option(f)
+		if test.Err != (err != nil) {
+			t.Errorf("%d: Expected error %v got %v", i, test.Err, err)
+			continue
+		}
+		if got := strings.Join(f.options, " "); got != test.Expected {
+			t.Errorf("%d: Expected %s got %s", i, test.Expected, got)
+		}
+	}
+}
+
+func TestSeekOptions(t *testing.T) {
+	tests := []struct {
+		Type     fileType
+		Option   FileOption
+		Expected string
+		Err      bool
+	}{
+		{Type: fileTypeInput, Option: WithSeek(90 * time.Second), Expected: "-ss 00:01:30"},
+		{Type: fileTypeOutput, Option: WithSeek(FramePosition(1, 25, 1).Duration()), Expected: "-ss 00:00:00.04"},
+		{Type: fileTypeOutput, Option: WithSeek(FramePosition(1, 0, 1).Duration()), Err: true},
+		{Type: fileTypeOutput, Option: WithDuration(FramePosition(25, 25, 1).Duration()), Expected: "-t 00:00:01"},
+		{Type: fileTypeOutput, Option: WithDuration(FramePosition(25, 25, -1).Duration()), Err: true},
+		{Type: fileTypeInput, Option: WithSeek(-time.Second), Err: true},
+		{Type: fileTypeInput, Option: WithSeekFromEnd(-10 * time.Second), Expected: "-sseof -00:00:10"},
+		{Type: fileTypeInput, Option: WithSeekFromEnd(0), Err: true},
+		{Type: fileTypeOutput, Option: WithSeekFromEnd(-10 * time.Second), Err: true},
+		{Type: fileTypeOutput, Option: WithEndPosition(2 * time.Minute), Expected: "-to 00:02:00"},
+		{Type: fileTypeInput, Option: WithEndPosition(2 * time.Minute), Expected: "-to 00:02:00"},
+		{Type: fileTypeOutput, Option: WithEndPosition(-time.Second), Err: true},
+		{Type: fileTypeInput, Option: WithAccurateSeek(false), Expected: "-noaccurate_seek"},
+		{Type: fileTypeInput, Option: WithAccurateSeek(true), Expected: "-accurate_seek"},
+		{Type: fileTypeOutput, Option: WithAccurateSeek(true), Err: true},
+		{Type: fileTypeInput, Option: WithTimeOffset(-500 * time.Millisecond), Expected: "-itsoffset -00:00:00.5"},
+		{Type: fileTypeOutput, Option: WithTimeOffset(time.Second), Err: true},
+	}
+
+	for i, test := range tests {
+		f := &File{typ: test.Type}
+		err := test.Option(f)
+		if test.Err != (err != nil) {
+			t.Errorf("%d: Expected error %v got %v", i, test.Err, err)
+			continue
+		}
+		if got := strings.Join(f.options, " "); got != test.Expected {
+			t.Errorf("%d: Expected %s got %s", i, test.Expected, got)
+		}
+	}
+}
+
+func TestWithMap(t *testing.T) {
+	input, output := Input("in.mp4"), Output("out.mp4")
+
+	tests := []struct {
+		Type   fileType
+		Option FileOption
+		Err    bool
+	}{
+		{Type: fileTypeOutput, Option: WithMap(input, VideoStreamSpecifier(0))},
+		{Type: fileTypeOutput, Option: WithMapLabel("out")},
+		{Type: fileTypeInput, Option: WithMap(input, VideoStreamSpecifier(0)), Err: true},
+		{Type: fileTypeOutput, Option: WithMap(output, VideoStreamSpecifier(0)), Err: true},
+		{Type: fileTypeOutput, Option: WithMap(nil, VideoStreamSpecifier(0)), Err: true},
+		{Type: fileTypeOutput, Option: WithMapLabel("[]"), Err: true},
+		{Type: fileTypeOutput, Option: WithMapLabel("a][b"), Err: true},
+	}
+
+	for i, test := range tests {
+		f := &File{typ: test.Type}
+		if err := test.Option(f); test.Err != (err != nil) {
+			t.Errorf("%d: Expected error %v got %v", i, test.Err, err)
+		}
+	}
+}
+
+func TestWithMetadata(t *testing.T) {
+	tests := []struct {
+		Type     fileType
+		Option   FileOption
+		Expected []string
+		Err      bool
+	}{
+		{Type: fileTypeOutput, Option: WithMetadata(GlobalMetadataScope(), "title", "A Film"), Expected: []string{"-metadata", "title=A Film"}},
+		{Type: fileTypeOutput, Option: WithMetadata(StreamMetadataScope(AudioStreamSpecifier(0)), "language", "eng"), Expected: []string{"-metadata:s:a:0", "language=eng"}},
+		{Type: fileTypeOutput, Option: WithMetadata(ChapterMetadataScope(1), "title", "Part 2"), Expected: []string{"-metadata:c:1", "title=Part 2"}},
+		{Type: fileTypeOutput, Option: WithMetadata(ProgramMetadataScope(0), "service_name", "News"), Expected: []string{"-metadata:p:0", "service_name=News"}},
+		{Type: fileTypeOutput, Option: WithMetadata(GlobalMetadataScope(), "comment", "a=b; 'c' \"d\"\n$e"), Expected: []string{"-metadata", "comment=a=b; 'c' \"d\"\n$e"}},
+		{Type: fileTypeOutput, Option: WithMetadata(GlobalMetadataScope(), "encoder", ""), Expected: []string{"-metadata", "encoder="}},
+		{Type: fileTypeOutput, Option: WithMetadata(GlobalMetadataScope(), "", "x"), Err: true},
+		{Type: fileTypeOutput, Option: WithMetadata(GlobalMetadataScope(), "a=b", "x"), Err: true},
+		{Type: fileTypeOutput, Option: WithMetadata(GlobalMetadataScope(), "title", "a\x00b"), Err: true},
+		{Type: fileTypeOutput, Option: WithMetadata(GlobalMetadataScope(), "title", "\x00"), Err: true},
+		{Type: fileTypeOutput, Option: WithMetadata(GlobalMetadataScope(), "description", "line 1\nline 2\r\n"), Expected: []string{"-metadata", "description=line 1\nline 2\r\n"}},
+		{Type: fileTypeInput, Option: WithMetadata(GlobalMetadataScope(), "title", "x"), Err: true},
+	}
+
+	for i, test := range tests {
+		f := &File{typ: test.Type}
+		err := test.Option(f)
+		if test.Err != (err != nil) {
+			t.Errorf("%d: Expected error %v got %v", i, test.Err, err)
+			continue
+		}
+		if !reflect.DeepEqual(f.options, test.Expected) {
+			t.Errorf("%d: Expected %q got %q", i, test.Expected, f.options)
+		}
+	}
+}
+
+func TestWithMapMetadata(t *testing.T) {
+	input, output := Input("in.mp4"), Output("out.mp4")
+
+	tests := []struct {
+		Type   fileType
+		Option FileOption
+		Err    bool
+	}{
+		{Type: fileTypeOutput, Option: WithMapMetadata(input, GlobalMetadataScope(), GlobalMetadataScope())},
+		{Type: fileTypeOutput, Option: WithMapMetadata(nil, GlobalMetadataScope(), StreamMetadataScope(AllStreamSpecifier()))},
+		{Type: fileTypeOutput, Option: WithMapChapters(input)},
+		{Type: fileTypeOutput, Option: WithMapChapters(nil)},
+		{Type: fileTypeOutput, Option: WithMapMetadata(nil, ChapterMetadataScope(0), GlobalMetadataScope()), Err: true},
+		{Type: fileTypeOutput, Option: WithMapMetadata(output, GlobalMetadataScope(), GlobalMetadataScope()), Err: true},
+		{Type: fileTypeOutput, Option: WithMapChapters(output), Err: true},
+		{Type: fileTypeInput, Option: WithMapMetadata(input, GlobalMetadataScope(), GlobalMetadataScope()), Err: true},
+		{Type: fileTypeInput, Option: WithMapChapters(nil), Err: true},
+	}
+
+	for i, test := range tests {
+		f := &File{typ: test.Type}
+		if err := test.Option(f); test.Err != (err != nil) {
+			t.Errorf("%d: Expected error %v got %v", i, test.Err, err)
+		}
+	}
+}
@@ -25,14 +25,38 @@ import (
 
 func WithSize(stream StreamSpecifier, w, h int) FileOption {
 	return func(f *File) error {
-		f.options = append(f.options, []string{"-s" + stream.String(), fmt.Sprintf("%dx%d", w, h)}...)
+		flag := "-s" + stream.String()
+		if err := stream.check(flag); err != nil {
+			return err
+		}
+		f.options = append(f.options, []string{flag, fmt.Sprintf("%dx%d", w, h)}...)
 		return nil
 	}
 }
 
+// WithPixelFormat sets the pixel format of one or more video streams.
+//
+// Output files can only be set pixel formats ffmpeg can convert to, or
+// hardware accelerated ones. Command also rejects pixel formats the
+// encoder of the streams, set with WithEncoder, does not support; see
+// BestPixelFormat.
 func WithPixelFormat(stream StreamSpecifier, pf PixelFormat) FileOption {
 	return func(f *File) error {
-		f.options = append(f.options, []string{"-pix_fmt" + stream.String(), pf.String()}...)
+		flag := "-pix_fmt" + stream.String()
+		if err := stream.check(flag); err != nil {
+			return err
+		}
+		if pf.String() == "" {
+			return fmt.Errorf("unable to apply %s flag: unknown pixel format %d", flag, pf)
+		}
+		if f.typ == fileTypeOutput && !pf.CanOutput() && !pf.IsHWAccel() {
+			return fmt.Errorf("unable to apply %s flag: ffmpeg can not convert to pixel format %s", flag, pf)
+		}
+		if f.pixelFormats == nil {
+			f.pixelFormats = map[string]PixelFormat{}
+		}
+		f.pixelFormats[stream.String()] = pf
+		f.options = append(f.options, []string{flag, pf.String()}...)
 		return nil
 	}
 }
@@ -0,0 +1,190 @@
+package ffmpeg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// globalFlags are the flags applying to the whole command rather than to
+// the file following them.
+var globalFlags = map[string]bool{
+	"loglevel": true, "v": true, "report": true, "max_alloc": true,
+	"cpuflags": true, "opencl_options": true, "y": true, "n": true,
+	"filter_threads": true, "stats": true, "progress": true, "debug_ts": true,
+	"qphist": true, "benchmark": true, "benchmark_all": true, "timelimit": true,
+	"dump": true, "hex": true, "filter_complex": true, "filter_complex_threads": true,
+	"lavfi": true, "filter_complex_script": true, "override_ffserver": true,
+	"sdp_file": true, "abort_on": true, "xerror": true, "hide_banner": true,
+	"stdin": true, "ignore_unknown": true, "copy_unknown": true,
+	"init_hw_device": true, "filter_hw_device": true, "vsync": true,
+	"async": true, "copyts": true, "start_at_zero": true, "copytb": true,
+	"dts_delta_threshold": true, "dts_error_threshold": true,
+	"stats_period": true, "max_error_rate": true,
+}
+
+// booleanFlags are the flags taking no value. Each can also be given
+// negated with a "no" prefix, such as "-nostats".
+var booleanFlags = map[string]bool{
+	"y": true, "n": true, "stats": true, "debug_ts": true, "qphist": true,
+	"benchmark": true, "benchmark_all": true, "dump": true, "hex": true,
+	"override_ffserver": true, "xerror": true, "hide_banner": true,
+	"stdin": true, "report": true, "ignore_unknown": true, "copy_unknown": true,
+	"copyts": true, "start_at_zero": true, "re": true, "accurate_seek": true,
+	"seek_timestamp": true, "shortest": true, "an": true, "vn": true,
+	"sn": true, "dn": true, "copyinkf": true, "autorotate": true,
+	"fix_sub_duration": true, "intra": true, "psnr": true, "vstats": true,
+	"deinterlace": true, "force_fps": true, "bitexact": true,
+	"find_stream_info": true, "autoscale": true, "recast_media": true,
+}
+
+// valueFlags are the flags known to take a value, even one starting with
+// a dash, such as "-itsoffset -5".
+var valueFlags = map[string]bool{
+	"i": true, "loglevel": true, "v": true, "max_alloc": true, "cpuflags": true,
+	"opencl_options": true, "filter_threads": true, "progress": true,
+	"timelimit": true, "filter_complex": true, "filter_complex_threads": true,
+	"lavfi": true, "filter_complex_script": true, "sdp_file": true,
+	"abort_on": true, "init_hw_device": true, "filter_hw_device": true,
+	"vsync": true, "async": true, "dts_delta_threshold": true,
+	"dts_error_threshold": true, "stats_period": true, "max_error_rate": true,
+	"f": true, "c": true, "codec": true, "pre": true, "map_metadata": true,
+	"t": true, "to": true, "fs": true, "ss": true, "sseof": true,
+	"itsoffset": true, "timestamp": true, "metadata": true, "target": true,
+	"dframes": true, "frames": true, "q": true, "qscale": true,
+	"filter": true, "filter_script": true, "attach": true,
+	"dump_attachment": true, "stream_loop": true, "disposition": true,
+	"vframes": true, "r": true, "s": true, "aspect": true, "vcodec": true,
+	"pass": true, "passlogfile": true, "vf": true, "pix_fmt": true,
+	"rc_override": true, "top": true, "vtag": true, "force_key_frames": true,
+	"hwaccel": true, "hwaccel_device": true, "aframes": true, "aq": true,
+	"ar": true, "ac": true, "acodec": true, "atag": true, "af": true,
+	"sample_fmt": true, "channel_layout": true, "guess_layout_max": true,
+	"scodec": true, "map": true, "thread_queue_size": true, "tag": true,
+	"map_chapters": true, "enc_time_base": true, "bsf": true,
+	"max_muxing_queue_size": true, "muxdelay": true, "muxpreload": true,
+	"streamid": true, "sws_flags": true,
+}
+
+// Parse parses a shell-style ffmpeg command line, as rendered by
+// Cmd.String, into its global options and files. A leading word that is
+// not a flag is taken as the ffmpeg binary, whatever its name, and is
+// skipped, as are DefaultBaseFlags which Command adds itself.
+//
+// Flags are kept verbatim, so passing the results to Command reproduces
+// the command line, including flags this package has no option for. Such
+// a flag is taken to have a value unless it is followed by another flag
+// or by the last word, which can only be an output file. The "-progress"
+// flag Cmd.Progress adds is dropped, as the pipe it refers to only exists
+// while that command runs.
+func Parse(cmdline string) (GlobalOptions, []*File, error) {
+	args, err := shellSplit(cmdline)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ffmpeg reads no file before a flag, so the first word can only be
+	// the binary
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		args = args[1:]
+	}
+
+	var global GlobalOptions
+	var files []*File
+	var options []string
+	var format string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "-" || !strings.HasPrefix(arg, "-") {
+			files = append(files, &File{
+				path:    arg,
+				options: options,
+				typ:     fileTypeOutput,
+				format:  format,
+			})
+			options, format = nil, ""
+			continue
+		}
+
+		name := strings.SplitN(arg[1:], ":", 2)[0]
+		flags := []string{arg}
+		if hasValue(name, args[i+1:]) {
+			if i+1 == len(args) {
+				return nil, nil, fmt.Errorf("unable to parse command line: missing value for %s flag", arg)
+			}
+			i++
+			flags = append(flags, args[i])
+		}
+
+		switch {
+		case name == "progress" && strings.HasPrefix(flags[1], "pipe:"):
+		case name == "i":
+			files = append(files, &File{
+				path:    flags[1],
+				options: options,
+				typ:     fileTypeInput,
+				format:  format,
+			})
+			options, format = nil, ""
+		case isBaseFlag(arg):
+		case isGlobalFlag(name):
+			global = append(global, flagsOption(flags))
+		default:
+			if name == "f" {
+				format = flags[1]
+			}
+			options = append(options, flags...)
+		}
+	}
+
+	if len(options) > 0 {
+		// ffmpeg ignores options following the last output, they are kept
+		// as global so they are not lost
+		global = append(global, flagsOption(options))
+	}
+
+	return global, files, nil
+}
+
+// hasValue reports whether the flag name takes a value, given the words
+// following it.
+func hasValue(name string, rest []string) bool {
+	switch {
+	case valueFlags[name]:
+		return true
+	case isBooleanFlag(name), len(rest) < 2:
+		return false
+	}
+	next := rest[0]
+	if next == "-" || !strings.HasPrefix(next, "-") {
+		return true
+	}
+	// a negative number is a value rather than a flag
+	_, err := strconv.ParseFloat(next, 64)
+	return err == nil
+}
+
+func isBooleanFlag(name string) bool {
+	return booleanFlags[name] || (strings.HasPrefix(name, "no") && booleanFlags[name[2:]])
+}
+
+func isGlobalFlag(name string) bool {
+	return globalFlags[name] || (strings.HasPrefix(name, "no") && booleanFlags[name[2:]] && globalFlags[name[2:]])
+}
+
+func isBaseFlag(arg string) bool {
+	for _, flag := range DefaultBaseFlags {
+		if arg == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// flagsOption returns a GlobalOption generating flags verbatim.
+func flagsOption(flags []string) GlobalOption {
+	return func() ([]string, error) {
+		return flags, nil
+	}
+}
@@ -0,0 +1,251 @@
+package ffmpeg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		Word     string
+		Expected string
+	}{
+		{"out.mp4", "out.mp4"},
+		{"pipe:3", "pipe:3"},
+		{"", "''"},
+		{"my video.mp4", "'my video.mp4'"},
+		{"it's.mp4", `'it'\''s.mp4'`},
+		{"scale=1280:-2,fps=30", "scale=1280:-2,fps=30"},
+		{"[0:v][1:v]overlay", "'[0:v][1:v]overlay'"},
+	}
+
+	for _, test := range tests {
+		got := shellQuote(test.Word)
+		if got != test.Expected {
+			t.Errorf("Expected %s got %s", test.Expected, got)
+		}
+		if words, err := shellSplit(got); err != nil || len(words) != 1 || words[0] != test.Word {
+			t.Errorf("Expected %q to split back to %q got %q (%v)", got, test.Word, words, err)
+		}
+	}
+}
+
+func TestShellSplit(t *testing.T) {
+	tests := []struct {
+		Cmdline  string
+		Expected []string
+		Error    bool
+	}{
+		{"ffmpeg -i in.mp4 out.mp4", []string{"ffmpeg", "-i", "in.mp4", "out.mp4"}, false},
+		{"  -y\t-i  'a b.mp4' ", []string{"-y", "-i", "a b.mp4"}, false},
+		{`-metadata "title=\"x\" \$HOME" out.mp4`, []string{"-metadata", `title="x" $HOME`, "out.mp4"}, false},
+		{"-i in.mp4 \\\n  out\\ file.mp4", []string{"-i", "in.mp4", "out file.mp4"}, false},
+		{"-i ''", []string{"-i", ""}, false},
+		{"-i 'in.mp4", nil, true},
+		{`-i "in.mp4`, nil, true},
+		{`-i in.mp4\`, nil, true},
+	}
+
+	for _, test := range tests {
+		got, err := shellSplit(test.Cmdline)
+		if test.Error {
+			if err == nil {
+				t.Errorf("Expected error splitting %q", test.Cmdline)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unable to split %q: %v", test.Cmdline, err)
+		} else if !reflect.DeepEqual(got, test.Expected) {
+			t.Errorf("Expected %q got %q", test.Expected, got)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		Cmdline  string
+		Expected string
+		Inputs   []string
+		Outputs  []string
+	}{
+		{
+			Cmdline:  "ffmpeg -hide_banner -nostdin -xerror -y -i in.mp4 -c:v libx264 -crf 23 out.mp4",
+			Expected: "-y -i in.mp4 -c:v libx264 -crf 23 out.mp4",
+			Inputs:   []string{"in.mp4"},
+			Outputs:  []string{"out.mp4"},
+		},
+		{
+			Cmdline:  "/usr/local/bin/ffmpeg -ss 10 -i 'my video.mp4' -i logo.png -filter_complex '[0:v][1:v]overlay' -an -nostats -f mp4 -",
+			Expected: "-filter_complex [0:v][1:v]overlay -nostats -ss 10 -i my video.mp4 -i logo.png -an -f mp4 -",
+			Inputs:   []string{"my video.mp4", "logo.png"},
+			Outputs:  []string{"-"},
+		},
+		{
+			Cmdline:  "/opt/ffmpeg-6/bin/ffmpeg-6 -noautorotate -i in.mov -apad pad_dur=2 -force_fps -r 25 -bitexact out.mp4",
+			Expected: "-noautorotate -i in.mov -apad pad_dur=2 -force_fps -r 25 -bitexact out.mp4",
+			Inputs:   []string{"in.mov"},
+			Outputs:  []string{"out.mp4"},
+		},
+		{
+			Cmdline:  "-i in.mkv -x264-params keyint=60 -frobnicate on a.mkv -map 0:a b.flac -y",
+			Expected: "-y -i in.mkv -x264-params keyint=60 -frobnicate on a.mkv -map 0:a b.flac",
+			Inputs:   []string{"in.mkv"},
+			Outputs:  []string{"a.mkv", "b.flac"},
+		},
+		{
+			Cmdline:  "ffmpeg -i a.mp4 -frobnicate -i b.mp4 -itsoffset -5 -i c.mp4 -wobble -2 -twiddle out.mp4",
+			Expected: "-i a.mp4 -frobnicate -i b.mp4 -itsoffset -5 -i c.mp4 -wobble -2 -twiddle out.mp4",
+			Inputs:   []string{"a.mp4", "b.mp4", "c.mp4"},
+			Outputs:  []string{"out.mp4"},
+		},
+		{
+			Cmdline:  "ffmpeg -progress pipe:3 -hide_banner -nostdin -xerror -progress progress.txt -i in.mp4 out.mp4",
+			Expected: "-progress progress.txt -i in.mp4 out.mp4",
+			Inputs:   []string{"in.mp4"},
+			Outputs:  []string{"out.mp4"},
+		},
+	}
+
+	for _, test := range tests {
+		global, files, err := Parse(test.Cmdline)
+		if err != nil {
+			t.Errorf("unable to parse %q: %v", test.Cmdline, err)
+			continue
+		}
+
+		var inputs, outputs []string
+		for _, f := range files {
+			if f.typ == fileTypeInput {
+				inputs = append(inputs, f.path)
+			} else {
+				outputs = append(outputs, f.path)
+			}
+		}
+		if !reflect.DeepEqual(inputs, test.Inputs) {
+			t.Errorf("Expected inputs %q got %q", test.Inputs, inputs)
+		}
+		if !reflect.DeepEqual(outputs, test.Outputs) {
+			t.Errorf("Expected outputs %q got %q", test.Outputs, outputs)
+		}
+
+		cmd, err := (&Runner{BaseFlags: []string{}}).Command(global, files...)
+		if err != nil {
+			t.Errorf("unable to create command: %v", err)
+			continue
+		}
+		if got := strings.Join(cmd.Args, " "); got != test.Expected {
+			t.Errorf("Expected %s got %s", test.Expected, got)
+		}
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	cmd, err := Command(
+		GlobalOptions{WithOverwrite(true), WithLogLevel(LogLevelError)},
+		Input("in put.mp4", WithFormat(FileFormatMp4)),
+		Output("it's out.mkv", WithCodec(VideoStreamSpecifier(-1), CodecH264)),
+	)
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	global, files, err := Parse(cmd.String())
+	if err != nil {
+		t.Fatalf("unable to parse %q: %v", cmd.String(), err)
+	}
+	parsed, err := Command(global, files...)
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	if !reflect.DeepEqual(parsed.Args, cmd.Args) {
+		t.Errorf("Expected %q got %q", cmd.Args, parsed.Args)
+	}
+	if parsed.String() != cmd.String() {
+		t.Errorf("Expected %s got %s", cmd.String(), parsed.String())
+	}
+}
+
+func TestParseRoundTripFlags(t *testing.T) {
+	for _, flags := range [][]string{
+		{"-force_fps"},
+		{"-force_fps:v"},
+		{"-apad", "pad_dur=2"},
+		{"-bitexact"},
+		{"-copyinkf"},
+		{"-noautorotate"},
+		{"-frobnicate"},
+		{"-frobnicate", "-shortest"},
+		{"-frobnicate", "-wobble", "-1"},
+	} {
+		cmd, err := (&Runner{Path: "/opt/ffmpeg-6/bin/ffmpeg-6"}).Command(
+			GlobalOptions{WithOverwrite(true)},
+			Input("in.mp4"),
+			Output("out.mp4", func(f *File) error {
+				f.options = append(f.options, flags...)
+				return nil
+			}),
+		)
+		if err != nil {
+			t.Fatalf("unable to create command: %v", err)
+		}
+
+		global, files, err := Parse(cmd.String())
+		if err != nil {
+			t.Errorf("unable to parse %q: %v", cmd.String(), err)
+			continue
+		}
+		parsed, err := (&Runner{Path: "/opt/ffmpeg-6/bin/ffmpeg-6"}).Command(global, files...)
+		if err != nil {
+			t.Errorf("unable to create command: %v", err)
+			continue
+		}
+		if parsed.String() != cmd.String() {
+			t.Errorf("Expected %s got %s", cmd.String(), parsed.String())
+		}
+		if len(files) != 2 || files[1].typ != fileTypeOutput || files[1].path != "out.mp4" {
+			t.Errorf("%s: Expected out.mp4 to be parsed as an output", flags)
+		}
+	}
+}
+
+func TestParseRoundTripProgress(t *testing.T) {
+	cmd, err := Command(nil,
+		Input("in.mp4", func(f *File) error {
+			f.options = append(f.options, "-frobnicate")
+			return nil
+		}),
+		Output("out.mp4"),
+	)
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+	expected := cmd.String()
+	cmd.Progress()
+
+	global, files, err := Parse(cmd.String())
+	if err != nil {
+		t.Fatalf("unable to parse %q: %v", cmd.String(), err)
+	}
+	parsed, err := Command(global, files...)
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+	if parsed.String() != expected {
+		t.Errorf("Expected %s got %s", expected, parsed.String())
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, cmdline := range []string{
+		"ffmpeg -i",
+		"ffmpeg -i in.mp4 -c:v",
+		"ffmpeg -i 'in.mp4",
+	} {
+		if _, _, err := Parse(cmdline); err == nil {
+			t.Errorf("Expected error parsing %q", cmdline)
+		}
+	}
+}
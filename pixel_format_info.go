@@ -0,0 +1,171 @@
+package ffmpeg
+
+// pixelFormatProps are the properties of a pixel format, as listed by
+// "ffmpeg -pix_fmts" and "ffprobe -show_pixel_formats".
+type pixelFormatProps int
+
+const (
+	pixelFormatInput pixelFormatProps = 1 << iota
+	pixelFormatOutput
+	pixelFormatHWAccel
+	pixelFormatPaletted
+	pixelFormatBitstream
+	pixelFormatPlanar
+	pixelFormatRGB
+	pixelFormatAlpha
+)
+
+type pixelFormatInfo struct {
+	props        pixelFormatProps
+	components   int
+	bitsPerPixel int
+	bitDepth     int
+	chroma       string
+}
+
+func (typ PixelFormat) info() pixelFormatInfo {
+	if typ < 0 || int(typ) >= len(pixelFormatInfos) {
+		return pixelFormatInfo{}
+	}
+	return pixelFormatInfos[typ]
+}
+
+// Components returns the number of components of the pixel format, such
+// as 3 for yuv420p and 4 for rgba. Hardware accelerated formats have none.
+func (typ PixelFormat) Components() int {
+	return typ.info().components
+}
+
+// BitsPerPixel returns the average number of bits a pixel takes, such as
+// 12 for yuv420p whose chroma planes are a quarter of the luma plane.
+func (typ PixelFormat) BitsPerPixel() int {
+	return typ.info().bitsPerPixel
+}
+
+// BitDepth returns the largest number of bits of a component, such as 10
+// for yuv420p10le.
+func (typ PixelFormat) BitDepth() int {
+	return typ.info().bitDepth
+}
+
+// IsPlanar reports whether the components are stored in separate planes.
+func (typ PixelFormat) IsPlanar() bool {
+	return typ.info().props&pixelFormatPlanar != 0
+}
+
+// HasAlpha reports whether the pixel format has an alpha component.
+func (typ PixelFormat) HasAlpha() bool {
+	return typ.info().props&pixelFormatAlpha != 0
+}
+
+// IsRGB reports whether the pixel format stores RGB rather than YUV or
+// gray components.
+func (typ PixelFormat) IsRGB() bool {
+	return typ.info().props&pixelFormatRGB != 0
+}
+
+// IsHWAccel reports whether the pixel format is a hardware accelerated
+// format, whose frames are kept in GPU memory.
+func (typ PixelFormat) IsHWAccel() bool {
+	return typ.info().props&pixelFormatHWAccel != 0
+}
+
+// IsPaletted reports whether the pixels are indexes into a palette.
+func (typ PixelFormat) IsPaletted() bool {
+	return typ.info().props&pixelFormatPaletted != 0
+}
+
+// IsBitstream reports whether the pixels are packed as a bitstream, such
+// as monob with 8 pixels to a byte.
+func (typ PixelFormat) IsBitstream() bool {
+	return typ.info().props&pixelFormatBitstream != 0
+}
+
+// ChromaSubsampling returns the chroma subsampling of a YUV pixel format,
+// such as "4:2:0" or "4:4:4", or "" for other pixel formats.
+func (typ PixelFormat) ChromaSubsampling() string {
+	return typ.info().chroma
+}
+
+// CanInput reports whether ffmpeg can convert from the pixel format.
+func (typ PixelFormat) CanInput() bool {
+	return typ.info().props&pixelFormatInput != 0
+}
+
+// CanOutput reports whether ffmpeg can convert to the pixel format.
+func (typ PixelFormat) CanOutput() bool {
+	return typ.info().props&pixelFormatOutput != 0
+}
+
+// chromaShifts are the log2 of the horizontal and vertical chroma
+// subsampling ratios, by their J:a:b notation.
+var chromaShifts = map[string][2]int{
+	"4:4:4": {0, 0},
+	"4:2:2": {1, 0},
+	"4:2:0": {1, 1},
+	"4:1:1": {2, 0},
+	"4:1:0": {2, 2},
+	"4:4:0": {0, 1},
+}
+
+// conversionLoss scores how much is lost converting from src to dst, the
+// higher the worse. Like ffmpeg, losing chroma or alpha is worse than
+// converting between RGB and YUV, which is worse than losing bit depth or
+// chroma resolution.
+func conversionLoss(src, dst PixelFormat) int {
+	loss := 0
+	if src.Components() > 2 && dst.Components() <= 2 {
+		loss += 8 << 16
+	}
+	if src.HasAlpha() && !dst.HasAlpha() {
+		loss += 4 << 16
+	}
+	if dst.IsPaletted() && !src.IsPaletted() {
+		loss += 2 << 16
+	}
+	if src.IsRGB() != dst.IsRGB() && src.Components() > 2 && dst.Components() > 2 {
+		loss += 1 << 16
+	}
+	if d := src.BitDepth() - dst.BitDepth(); d > 0 {
+		loss += d << 8
+	}
+	srcShift, dstShift := chromaShifts[src.ChromaSubsampling()], chromaShifts[dst.ChromaSubsampling()]
+	for i := range srcShift {
+		if d := dstShift[i] - srcShift[i]; d > 0 {
+			loss += d << 4
+		}
+	}
+	return loss
+}
+
+// BestPixelFormat returns the pixel format supported by enc that frames of
+// the source pixel format lose the least converting to, preferring the
+// smallest of those losing as little, then the first enc lists. That is
+// source itself when enc supports it.
+//
+// It returns false if enc does not list the pixel formats it supports, in
+// which case the choice is best left to ffmpeg.
+func BestPixelFormat(enc Encoder, source PixelFormat) (PixelFormat, bool) {
+	pfs := enc.PixelFormats()
+	if len(pfs) == 0 {
+		return source, false
+	}
+
+	best, bestLoss := PixelFormat(0), -1
+	for _, pf := range pfs {
+		if pf == source {
+			return pf, true
+		}
+		if pf.IsHWAccel() {
+			continue
+		}
+		loss := conversionLoss(source, pf)
+		if bestLoss < 0 || loss < bestLoss || (loss == bestLoss && pf.BitsPerPixel() < best.BitsPerPixel()) {
+			best, bestLoss = pf, loss
+		}
+	}
+	if bestLoss < 0 {
+		return source, false
+	}
+	return best, true
+}
@@ -0,0 +1,74 @@
+package ffmpeg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPixelFormatInfo(t *testing.T) {
+	tests := []struct {
+		PixelFormat  PixelFormat
+		Components   int
+		BitsPerPixel int
+		BitDepth     int
+		Chroma       string
+		// planar, alpha, rgb, hwaccel, paletted, bitstream, input, output
+		Props []bool
+	}{
+		{PixelFormatYuv420P, 3, 12, 8, "4:2:0", []bool{true, false, false, false, false, false, true, true}},
+		{PixelFormatYuv420P10Le, 3, 15, 10, "4:2:0", []bool{true, false, false, false, false, false, true, true}},
+		{PixelFormatYuva444P16Le, 4, 64, 16, "4:4:4", []bool{true, true, false, false, false, false, true, true}},
+		{PixelFormatUyvy422, 3, 16, 8, "4:2:2", []bool{false, false, false, false, false, false, true, true}},
+		{PixelFormatP010Le, 3, 15, 10, "4:2:0", []bool{true, false, false, false, false, false, true, true}},
+		{PixelFormatRgba, 4, 32, 8, "", []bool{false, true, true, false, false, false, true, true}},
+		{PixelFormatGbrp12Le, 3, 36, 12, "", []bool{true, false, true, false, false, false, true, true}},
+		{PixelFormatPal8, 1, 8, 8, "", []bool{false, true, false, false, true, false, true, true}},
+		{PixelFormatMonob, 1, 1, 1, "", []bool{false, false, false, false, false, true, true, true}},
+		{PixelFormatBayerRggb8, 3, 8, 4, "", []bool{false, false, true, false, false, false, true, false}},
+		{PixelFormatVideotoolboxVld, 0, 0, 0, "", []bool{false, false, false, true, false, false, false, false}},
+		{PixelFormat(-1), 0, 0, 0, "", []bool{false, false, false, false, false, false, false, false}},
+	}
+
+	for _, test := range tests {
+		pf := test.PixelFormat
+		if got := []int{pf.Components(), pf.BitsPerPixel(), pf.BitDepth()}; !reflect.DeepEqual(got, []int{test.Components, test.BitsPerPixel, test.BitDepth}) {
+			t.Errorf("%s: Expected components, bits per pixel, bit depth %v got %v", pf, []int{test.Components, test.BitsPerPixel, test.BitDepth}, got)
+		}
+		if got := pf.ChromaSubsampling(); got != test.Chroma {
+			t.Errorf("%s: Expected chroma subsampling %q got %q", pf, test.Chroma, got)
+		}
+		got := []bool{pf.IsPlanar(), pf.HasAlpha(), pf.IsRGB(), pf.IsHWAccel(), pf.IsPaletted(), pf.IsBitstream(), pf.CanInput(), pf.CanOutput()}
+		if !reflect.DeepEqual(got, test.Props) {
+			t.Errorf("%s: Expected %v got %v", pf, test.Props, got)
+		}
+	}
+}
+
+func TestBestPixelFormat(t *testing.T) {
+	tests := []struct {
+		Encoder  Encoder
+		Source   PixelFormat
+		Expected PixelFormat
+		OK       bool
+	}{
+		{EncoderLibx264, PixelFormatYuv420P, PixelFormatYuv420P, true},
+		{EncoderLibx264, PixelFormatYuv420P10Le, PixelFormatYuv420P, true},
+		{EncoderLibx264, PixelFormatYuva444P, PixelFormatYuv444P, true},
+		{EncoderLibx264, PixelFormatRgb24, PixelFormatYuv444P, true},
+		{EncoderLibx264Rgb, PixelFormatYuv420P, PixelFormatBgr0, true},
+		{EncoderMjpeg, PixelFormatYuv420P, PixelFormatYuvj420P, true},
+		{EncoderProresKs, PixelFormatYuv420P, PixelFormatYuv422P10Le, true},
+		{EncoderProresKs, PixelFormatRgba, PixelFormatYuva444P10Le, true},
+		{EncoderPng, PixelFormatYuva420P, PixelFormatRgba, true},
+		{EncoderH264Videotoolbox, PixelFormatYuv444P, PixelFormatNv12, true},
+		{EncoderRawvideo, PixelFormatYuv444P, PixelFormatYuv444P, false},
+		{EncoderAac, PixelFormatYuv420P, PixelFormatYuv420P, false},
+	}
+
+	for _, test := range tests {
+		pf, ok := BestPixelFormat(test.Encoder, test.Source)
+		if pf != test.Expected || ok != test.OK {
+			t.Errorf("%s from %s: Expected %s, %v got %s, %v", test.Encoder, test.Source, test.Expected, test.OK, pf, ok)
+		}
+	}
+}
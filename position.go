@@ -0,0 +1,153 @@
+package ffmpeg
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Position is a position in a file or a duration, as taken by -ss, -sseof,
+// -to, -t, -itsoffset and -timelimit. It is built from a time.Duration by
+// converting it, such as Position(90 * time.Second), from a frame count by
+// FramePosition, or from any syntax ffmpeg accepts by ParsePosition. The
+// options setting those flags take its Duration.
+//
+// Negative positions are offsets back in time, such as from the end of a
+// file with -sseof.
+//
+// ffmpeg reads positions to the microsecond, so anything finer is dropped.
+type Position time.Duration
+
+// FramePosition returns the position of frame, counted from 0, of a stream
+// with the frame rate num/den frames per second, such as 30000/1001. It is
+// rounded down to the microsecond, so seeking to it keeps the frame.
+//
+// If num or den is not positive it returns an invalid position, which the
+// options given its Duration return an error for.
+func FramePosition(frame, num, den int64) Position {
+	if num <= 0 || den <= 0 {
+		return invalidPosition
+	}
+	us := frame * den / num * 1e6
+	us += frame * den % num * 1e6 / num
+	return Position(time.Duration(us) * time.Microsecond)
+}
+
+// invalidPosition is returned by FramePosition for an invalid frame rate.
+// It is the smallest time.Duration, which no valid position is given as,
+// so it is still recognized once converted to one.
+const invalidPosition = Position(math.MinInt64)
+
+// checkPosition returns an error if pos, given to the flag, is invalid.
+func checkPosition(flag string, pos Position) error {
+	if pos == invalidPosition {
+		return fmt.Errorf("unable to apply -%s flag: invalid position, from a frame rate that is not positive", flag)
+	}
+	return nil
+}
+
+// Duration returns the position as a time.Duration.
+func (p Position) Duration() time.Duration {
+	return time.Duration(p)
+}
+
+// String returns the position in the sexagesimal syntax of ffmpeg, such as
+// "01:20:04.053" or "-00:00:05". Hours are not wrapped at 24.
+func (p Position) String() string {
+	us := int64(time.Duration(p) / time.Microsecond)
+
+	sign := ""
+	if us < 0 {
+		sign, us = "-", -us
+	}
+	s := fmt.Sprintf("%s%02d:%02d:%02d", sign, us/3600e6, us/60e6%60, us/1e6%60)
+	if frac := us % 1e6; frac != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%06d", frac), "0")
+	}
+	return s
+}
+
+// seconds returns the position as a whole number of seconds, rounded up.
+func (p Position) seconds() int64 {
+	d := time.Duration(p)
+	s := int64(d / time.Second)
+	if d%time.Second > 0 {
+		s++
+	}
+	return s
+}
+
+// regexpPosition matches the syntaxes of a position ffmpeg accepts,
+// "[-][HH:]MM:SS[.m...]" and "[-]S+[.m...][s|ms|us]".
+var regexpPosition = regexp.MustCompile(`^(-)?(?:(?:(\d+):)?(\d{1,2}):(\d{1,2})|(\d+))(?:\.(\d*))?(s|ms|us)?$`)
+
+// ParsePosition parses a position in any syntax ffmpeg accepts, such as
+// "01:20:04.053", "20:04", "-5", "4.5" or "500ms".
+func ParsePosition(s string) (Position, error) {
+	m := regexpPosition.FindStringSubmatch(s)
+	if m == nil || (m[7] != "" && m[5] == "") {
+		return 0, fmt.Errorf("invalid position %q", s)
+	}
+
+	var hours, minutes, seconds int64
+	var err error
+	if m[5] != "" {
+		seconds, err = strconv.ParseInt(m[5], 10, 64)
+	} else {
+		if m[2] != "" {
+			hours, err = strconv.ParseInt(m[2], 10, 64)
+		}
+		minutes, _ = strconv.ParseInt(m[3], 10, 64)
+		seconds, _ = strconv.ParseInt(m[4], 10, 64)
+		if minutes > 59 || seconds > 59 {
+			return 0, fmt.Errorf("invalid position %q", s)
+		}
+	}
+	// time.Duration holds about 292 years
+	const maxSeconds = math.MaxInt64/int64(time.Second) - 1
+	if err != nil || hours > maxSeconds/3600 || (hours*60+minutes)*60+seconds > maxSeconds {
+		return 0, fmt.Errorf("invalid position %q: out of range", s)
+	}
+
+	us := ((hours*60+minutes)*60 + seconds) * 1e6
+	if frac := m[6]; frac != "" {
+		if len(frac) > 6 {
+			frac = frac[:6]
+		}
+		f, _ := strconv.ParseInt(frac+strings.Repeat("0", 6-len(frac)), 10, 64)
+		us += f
+	}
+	switch m[7] {
+	case "ms":
+		us /= 1e3
+	case "us":
+		us /= 1e6
+	}
+	if m[1] != "" {
+		us = -us
+	}
+	return Position(time.Duration(us) * time.Microsecond), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Position) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Position) UnmarshalText(text []byte) error {
+	return p.Set(string(text))
+}
+
+// Set implements flag.Value.
+func (p *Position) Set(s string) error {
+	parsed, err := ParsePosition(s)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
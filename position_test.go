@@ -0,0 +1,77 @@
+package ffmpeg
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPositionString(t *testing.T) {
+	tests := []struct {
+		Position Position
+		Expected string
+	}{
+		{Position(0), "00:00:00"},
+		{Position(80*time.Minute + 4*time.Second + 53*time.Millisecond), "01:20:04.053"},
+		{Position(500 * time.Millisecond), "00:00:00.5"},
+		{Position(time.Microsecond), "00:00:00.000001"},
+		{Position(time.Nanosecond), "00:00:00"},
+		{Position(100 * time.Hour), "100:00:00"},
+		{Position(-5 * time.Second), "-00:00:05"},
+		{Position(-1500 * time.Millisecond), "-00:00:01.5"},
+		{FramePosition(1, 30000, 1001), "00:00:00.033366"},
+		{FramePosition(1800, 30000, 1001), "00:01:00.06"},
+		{FramePosition(48, 24, 1), "00:00:02"},
+		{FramePosition(-25, 25, 1), "-00:00:01"},
+	}
+
+	for _, test := range tests {
+		if got := test.Position.String(); got != test.Expected {
+			t.Errorf("%s: Expected %s got %s", test.Position.Duration(), test.Expected, got)
+		}
+	}
+}
+
+func TestParsePosition(t *testing.T) {
+	tests := []struct {
+		Input    string
+		Expected time.Duration
+		Err      bool
+	}{
+		{Input: "01:20:04.053", Expected: 80*time.Minute + 4*time.Second + 53*time.Millisecond},
+		{Input: "1:2:3", Expected: time.Hour + 2*time.Minute + 3*time.Second},
+		{Input: "20:04", Expected: 20*time.Minute + 4*time.Second},
+		{Input: "100:00:00", Expected: 100 * time.Hour},
+		{Input: "-00:00:05", Expected: -5 * time.Second},
+		{Input: "4.5", Expected: 4500 * time.Millisecond},
+		{Input: "90", Expected: 90 * time.Second},
+		{Input: "-5", Expected: -5 * time.Second},
+		{Input: "3.", Expected: 3 * time.Second},
+		{Input: "0.1234567", Expected: 123456 * time.Microsecond},
+		{Input: "2s", Expected: 2 * time.Second},
+		{Input: "500ms", Expected: 500 * time.Millisecond},
+		{Input: "1.5ms", Expected: 1500 * time.Microsecond},
+		{Input: "250us", Expected: 250 * time.Microsecond},
+		{Input: "00:60:00", Err: true},
+		{Input: "01:20s", Err: true},
+		{Input: "1h", Err: true},
+		{Input: "", Err: true},
+		{Input: "99999999999999999999", Err: true},
+	}
+
+	for _, test := range tests {
+		got, err := ParsePosition(test.Input)
+		if test.Err != (err != nil) {
+			t.Errorf("%q: Expected error %v got %v", test.Input, test.Err, err)
+			continue
+		}
+		if got.Duration() != test.Expected {
+			t.Errorf("%q: Expected %s got %s", test.Input, test.Expected, got.Duration())
+		}
+		if test.Err {
+			continue
+		}
+		if again, err := ParsePosition(got.String()); err != nil || again != got {
+			t.Errorf("%q: %s does not round-trip: %v %v", test.Input, got, again.Duration(), err)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package ffmpeg
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Progress is a snapshot of an encode's progress, as reported by ffmpeg's
+// "-progress" option.
+type Progress struct {
+	Frame      int64         // Number of frames processed
+	FPS        float64       // Frames processed per second
+	Bitrate    float64       // Output bitrate in kbit/s
+	TotalSize  int64         // Output size in bytes
+	OutTime    time.Duration // Timestamp of the output reached
+	DupFrames  int64         // Number of duplicated frames
+	DropFrames int64         // Number of dropped frames
+	Speed      float64       // Processing speed relative to realtime
+	Done       bool          // Whether this is the final report
+}
+
+// readProgress parses the key=value blocks written by "-progress" from r,
+// sending a Progress on ch for each completed block. ch is closed when r
+// is exhausted.
+func readProgress(r io.Reader, ch chan Progress) {
+	defer close(ch)
+
+	var p Progress
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		kv := strings.SplitN(s.Text(), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+
+		switch key {
+		case "frame":
+			p.Frame, _ = strconv.ParseInt(value, 10, 64)
+		case "fps":
+			p.FPS, _ = strconv.ParseFloat(value, 64)
+		case "bitrate":
+			p.Bitrate, _ = strconv.ParseFloat(strings.TrimSuffix(value, "kbits/s"), 64)
+		case "total_size":
+			p.TotalSize, _ = strconv.ParseInt(value, 10, 64)
+		case "out_time_us", "out_time_ms":
+			// out_time_ms is reported in microseconds as well
+			if us, err := strconv.ParseInt(value, 10, 64); err == nil {
+				p.OutTime = time.Duration(us) * time.Microsecond
+			}
+		case "dup_frames":
+			p.DupFrames, _ = strconv.ParseInt(value, 10, 64)
+		case "drop_frames":
+			p.DropFrames, _ = strconv.ParseInt(value, 10, 64)
+		case "speed":
+			p.Speed, _ = strconv.ParseFloat(strings.TrimSuffix(value, "x"), 64)
+		case "progress":
+			p.Done = value == "end"
+			sendProgress(ch, p)
+			p = Progress{}
+		}
+	}
+
+	// keep draining so ffmpeg never blocks writing progress
+	io.Copy(ioutil.Discard, r)
+}
+
+// sendProgress delivers p on ch without blocking, replacing a report the
+// receiver has not picked up yet, so a slow receiver never stalls ffmpeg.
+func sendProgress(ch chan Progress, p Progress) {
+	for {
+		select {
+		case ch <- p:
+			return
+		default:
+		}
+
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package ffmpeg
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadProgress(t *testing.T) {
+	input := `frame=24
+fps=0.00
+stream_0_0_q=28.0
+bitrate=N/A
+total_size=48
+out_time_ms=1000000
+out_time=00:00:01.000000
+dup_frames=0
+drop_frames=0
+speed=N/A
+progress=continue
+frame=48
+fps=47.52
+stream_0_0_q=-1.0
+bitrate= 512.3kbits/s
+total_size=128070
+out_time_us=2000000
+out_time=00:00:02.000000
+dup_frames=1
+drop_frames=2
+speed=1.98x
+progress=end
+`
+	expected := []Progress{
+		{Frame: 24, TotalSize: 48, OutTime: time.Second},
+		{Frame: 48, FPS: 47.52, Bitrate: 512.3, TotalSize: 128070, OutTime: 2 * time.Second, DupFrames: 1, DropFrames: 2, Speed: 1.98, Done: true},
+	}
+
+	ch := make(chan Progress, len(expected))
+	readProgress(strings.NewReader(input), ch)
+
+	var got []Progress
+	for p := range ch {
+		got = append(got, p)
+	}
+
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d reports got %d", len(expected), len(got))
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Expected %+v got %+v", expected[i], got[i])
+		}
+	}
+}
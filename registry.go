@@ -0,0 +1,156 @@
+package ffmpeg
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/benhinchley/ffmpeg/internal/listing"
+)
+
+// Registry holds the codecs, formats and pixel formats supported by an
+// ffmpeg binary, as it lists them.
+//
+// The Codec, FileFormat and PixelFormat constants are generated from a
+// particular ffmpeg build, so the installed binary may support names they
+// are missing, and lack some of them.
+type Registry struct {
+	Codecs       []RegistryEntry
+	Formats      []RegistryEntry
+	PixelFormats []RegistryEntry
+}
+
+// RegistryEntry is a codec, format or pixel format listed by ffmpeg.
+type RegistryEntry struct {
+	// Name is the name of the entry. Formats sharing a demuxer are listed
+	// under a comma separated list of names, such as "matroska,webm".
+	Name string
+
+	// Flags is the capability column of the listing, such as "DEV.LS" for
+	// a codec.
+	Flags string
+
+	// Description is the rest of the listing line. For pixel formats it
+	// holds the component count and bits per pixel.
+	Description string
+}
+
+// LoadRegistry loads the registry of the ffmpeg binary used by
+// DefaultRunner.
+func LoadRegistry() (*Registry, error) {
+	return DefaultRunner.LoadRegistry()
+}
+
+// LoadRegistry loads the registry of the ffmpeg binary of the runner by
+// running "ffmpeg -codecs", "ffmpeg -formats" and "ffmpeg -pix_fmts".
+//
+// The result is cached, so ffmpeg is only run the first time it succeeds.
+func (r *Runner) LoadRegistry() (*Registry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.registry != nil {
+		return r.registry, nil
+	}
+
+	reg := &Registry{}
+	for _, l := range []struct {
+		flag    string
+		entries *[]RegistryEntry
+	}{
+		{"-codecs", &reg.Codecs},
+		{"-formats", &reg.Formats},
+		{"-pix_fmts", &reg.PixelFormats},
+	} {
+		out, err := r.output(l.flag)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list ffmpeg %s: %v", l.flag[1:], err)
+		}
+		entries, err := listing.Parse(bytes.NewReader(out))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse ffmpeg %s: %v", l.flag[1:], err)
+		}
+		for _, e := range entries {
+			*l.entries = append(*l.entries, RegistryEntry{
+				Name:        e.Name,
+				Flags:       e.Flags,
+				Description: e.Description,
+			})
+		}
+	}
+
+	r.registry = reg
+	return reg, nil
+}
+
+// Codec looks up a codec by name.
+func (reg *Registry) Codec(name string) (RegistryEntry, bool) {
+	return lookupEntry(reg.Codecs, name)
+}
+
+// Format looks up a format by name, including the names of formats
+// listed under several names.
+func (reg *Registry) Format(name string) (RegistryEntry, bool) {
+	return lookupEntry(reg.Formats, name)
+}
+
+// PixelFormat looks up a pixel format by name.
+func (reg *Registry) PixelFormat(name string) (RegistryEntry, bool) {
+	return lookupEntry(reg.PixelFormats, name)
+}
+
+// UnsupportedCodecs returns the Codec constants the binary does not list.
+func (reg *Registry) UnsupportedCodecs() []Codec {
+	var unsupported []Codec
+	for c := Codec(0); c.String() != ""; c++ {
+		if _, ok := reg.Codec(c.String()); !ok {
+			unsupported = append(unsupported, c)
+		}
+	}
+	return unsupported
+}
+
+// UnsupportedFormats returns the FileFormat constants the binary does not
+// list.
+func (reg *Registry) UnsupportedFormats() []FileFormat {
+	var unsupported []FileFormat
+	for f := FileFormat(0); f.String() != ""; f++ {
+		if _, ok := reg.Format(f.String()); !ok {
+			unsupported = append(unsupported, f)
+		}
+	}
+	return unsupported
+}
+
+// UnsupportedPixelFormats returns the PixelFormat constants the binary
+// does not list.
+func (reg *Registry) UnsupportedPixelFormats() []PixelFormat {
+	var unsupported []PixelFormat
+	for pf := PixelFormat(0); pf.String() != ""; pf++ {
+		if _, ok := reg.PixelFormat(pf.String()); !ok {
+			unsupported = append(unsupported, pf)
+		}
+	}
+	return unsupported
+}
+
+// lookupEntry finds the entry listed under name, preferring an entry
+// listed under that name alone over one listed under several names.
+func lookupEntry(entries []RegistryEntry, name string) (RegistryEntry, bool) {
+	var found RegistryEntry
+	ok := false
+	for _, e := range entries {
+		if e.Name == name {
+			return e, true
+		}
+		if ok {
+			continue
+		}
+		for _, n := range strings.Split(e.Name, ",") {
+			if n == name {
+				found, ok = e, true
+			}
+		}
+	}
+	return found, ok
+}
@@ -0,0 +1,72 @@
+package ffmpeg
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/benhinchley/ffmpeg/ffmpegtest"
+)
+
+func TestLoadRegistry(t *testing.T) {
+	listings := map[string]string{}
+	for flag, file := range map[string]string{
+		"-codecs":   "codecs.txt",
+		"-formats":  "formats.txt",
+		"-pix_fmts": "pix_fmts.txt",
+	} {
+		b, err := ioutil.ReadFile(filepath.Join("internal", "listing", "testdata", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		listings[flag] = string(b)
+	}
+
+	stub := ffmpegtest.New(t, ffmpegtest.Script{Listings: listings})
+	r := &Runner{Path: stub.Path, Env: stub.Env}
+
+	reg, err := r.LoadRegistry()
+	if err != nil {
+		t.Fatalf("unable to load registry: %v", err)
+	}
+	if again, err := r.LoadRegistry(); err != nil || again != reg {
+		t.Errorf("Expected cached registry got %+v (%v)", again, err)
+	}
+	if calls := stub.Calls(); len(calls) != 3 {
+		t.Errorf("Expected ffmpeg to be run 3 times got %d", len(calls))
+	}
+
+	if e, ok := reg.Codec("h264"); !ok || e.Flags != "DEV.LS" {
+		t.Errorf("Unexpected h264 codec %+v", e)
+	}
+	if _, ok := reg.Codec("av1"); ok {
+		t.Errorf("Expected av1 codec not to be listed")
+	}
+	if e, ok := reg.Format("webm"); !ok || e.Name != "matroska,webm" {
+		t.Errorf("Unexpected webm format %+v", e)
+	}
+	if e, ok := reg.Format("matroska"); !ok || e.Flags != " E" {
+		t.Errorf("Unexpected matroska format %+v", e)
+	}
+	if e, ok := reg.PixelFormat("videotoolbox_vld"); !ok || e.Flags != "..H.." {
+		t.Errorf("Unexpected videotoolbox_vld pixel format %+v", e)
+	}
+
+	unsupported := map[string]bool{}
+	for _, c := range reg.UnsupportedCodecs() {
+		unsupported[c.String()] = true
+	}
+	if !unsupported["av1"] || unsupported["h264"] || unsupported["bin_data"] {
+		t.Errorf("Unexpected unsupported codecs %v", unsupported)
+	}
+	unsupported = map[string]bool{}
+	for _, f := range reg.UnsupportedFormats() {
+		unsupported[f.String()] = true
+	}
+	if !unsupported["flv"] || unsupported["mp4"] || unsupported["matroska"] || unsupported["mpegts"] {
+		t.Errorf("Unexpected unsupported formats %v", unsupported)
+	}
+	if n := len(reg.UnsupportedPixelFormats()); n != 191-4 {
+		t.Errorf("Expected %d unsupported pixel formats got %d", 191-4, n)
+	}
+}
@@ -0,0 +1,199 @@
+package ffmpeg
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sync"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// DefaultBaseFlags are the flags passed to ffmpeg before any other option
+// when a Runner does not set its own.
+//
+// They suppress printing the banner, disable stdin interaction and make
+// ffmpeg stop and exit on error.
+var DefaultBaseFlags = []string{"-hide_banner", "-nostdin", "-xerror"}
+
+// DefaultRunner is the Runner used by Command and CommandContext.
+var DefaultRunner = &Runner{}
+
+// Runner configures which ffmpeg binary commands are run with and the
+// environment they are run in.
+//
+// The zero value runs "ffmpeg" found in the PATH, in the current working
+// directory, with the environment of the current process.
+type Runner struct {
+	// Path is the path of the ffmpeg binary. If empty, "ffmpeg" is looked
+	// up in the PATH.
+	Path string
+
+	// Env holds additional environment variables in the form "key=value".
+	// They are merged over the environment of the current process, unless
+	// IsolateEnv is set.
+	Env []string
+
+	// IsolateEnv stops the environment of the current process from being
+	// inherited, so ffmpeg only sees the variables in Env.
+	IsolateEnv bool
+
+	// Dir is the working directory of ffmpeg. If empty, ffmpeg runs in the
+	// current working directory.
+	Dir string
+
+	// BaseFlags are passed to ffmpeg before any other option. If nil,
+	// DefaultBaseFlags is used.
+	BaseFlags []string
+
+	// Executor starts the ffmpeg processes. If nil, LocalExecutor is used.
+	Executor Executor
+
+	// LogHandler is the LogHandler of the commands created. See Cmd.
+	LogHandler slog.Handler
+
+	// Capabilities, if set, are checked by Command so options the build
+	// of ffmpeg can not honour are rejected before it is run. They are
+	// usually set from DetectCapabilities.
+	Capabilities *Capabilities
+
+	mu       sync.Mutex
+	caps     *Capabilities
+	registry *Registry
+}
+
+// Command creates a new Cmd instance
+func (r *Runner) Command(global GlobalOptions, files ...*File) (*Cmd, error) {
+	return r.CommandContext(context.Background(), global, files...)
+}
+
+// CommandContext is like Command but includes a context.
+//
+// The provided context is used to stop the command if the context becomes
+// done before the command completes on its own. See Cmd.RunContext for how
+// the command is stopped.
+func (r *Runner) CommandContext(ctx context.Context, global GlobalOptions, files ...*File) (*Cmd, error) {
+	if ctx == nil {
+		panic("nil Context")
+	}
+
+	var i, o []*File
+	var err *multierror.Error
+
+	for _, file := range files {
+		switch file.typ {
+		case fileTypeInput:
+			if file.err != nil {
+				err = multierror.Append(err, file.err)
+			} else if perr := file.checkPositions(); perr != nil {
+				err = multierror.Append(err, perr)
+			} else {
+				i = append(i, file)
+			}
+		case fileTypeOutput:
+			if file.err != nil {
+				err = multierror.Append(err, file.err)
+			} else if perr := file.checkPixelFormats(); perr != nil {
+				err = multierror.Append(err, perr)
+			} else if perr := file.checkPositions(); perr != nil {
+				err = multierror.Append(err, perr)
+			} else {
+				o = append(o, file)
+			}
+		}
+	}
+
+	// maps refer to inputs by their index, so only resolve once they are
+	// all known
+	maps := make([][]string, len(o))
+	for n, output := range o {
+		var merr error
+		if maps[n], merr = output.resolveMaps(i); merr != nil {
+			err = multierror.Append(err, merr)
+		}
+	}
+
+	gf, gerr := global.flags()
+	if gerr != nil {
+		err = multierror.Append(err, gerr)
+	}
+
+	if err.ErrorOrNil() != nil {
+		return nil, err
+	}
+
+	cmd := &Cmd{
+		path:     r.path(),
+		env:      r.env(),
+		dir:      r.Dir,
+		ctx:      ctx,
+		executor: r.executor(),
+
+		LogHandler: r.LogHandler,
+	}
+	// streamed files are passed the url of their pipe, leaving the File
+	// as it is so it can be used in other commands
+	f := gf
+	for _, input := range i {
+		path := input.path
+		if input.reader != nil {
+			path = cmd.addPipe(readerPipe(input.reader))
+		}
+		f = append(f, input.flags(path, nil)...)
+	}
+	for n, output := range o {
+		path := output.path
+		if output.writer != nil {
+			path = cmd.addPipe(writerPipe(output.writer))
+		}
+		f = append(f, output.flags(path, maps[n])...)
+	}
+
+	base := r.BaseFlags
+	if base == nil {
+		base = DefaultBaseFlags
+	}
+	cmd.Args = append(append([]string(nil), base...), f...)
+
+	if r.Capabilities != nil {
+		var cerr *multierror.Error
+		if err := r.Capabilities.check(cmd.Args); err != nil {
+			cerr = multierror.Append(cerr, err)
+		}
+		for _, input := range i {
+			if err := r.Capabilities.checkInput(input); err != nil {
+				cerr = multierror.Append(cerr, err)
+			}
+		}
+		if cerr.ErrorOrNil() != nil {
+			return nil, cerr
+		}
+	}
+
+	return cmd, nil
+}
+
+func (r *Runner) executor() Executor {
+	if r.Executor == nil {
+		return LocalExecutor{}
+	}
+	return r.Executor
+}
+
+func (r *Runner) path() string {
+	if r.Path == "" {
+		return "ffmpeg"
+	}
+	return r.Path
+}
+
+// env builds the environment ffmpeg is run with. Later entries take
+// precedence over earlier ones with the same key.
+func (r *Runner) env() []string {
+	var env []string
+	if !r.IsolateEnv {
+		env = append(env, os.Environ()...)
+	}
+	env = append(env, r.Env...)
+	return append(env, "AV_LOG_FORCE_NOCOLOR=TRUE")
+}
@@ -0,0 +1,235 @@
+package ffmpeg
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunnerCommand(t *testing.T) {
+	r := &Runner{
+		Path:      "/opt/ffmpeg/bin/ffmpeg",
+		Env:       []string{"FONTCONFIG_PATH=/opt/fonts"},
+		Dir:       "/tmp",
+		BaseFlags: []string{"-nostdin"},
+	}
+
+	cmd, err := r.Command(GlobalOptions{WithOverwrite(true)}, Input("in.mp4"), Output("out.mp4"))
+	if err != nil {
+		t.Fatalf("unable to create command: %v", err)
+	}
+
+	expected := "-nostdin -y -i in.mp4 out.mp4"
+	if got := strings.Join(cmd.Args, " "); got != expected {
+		t.Errorf("Expected %s got %s", expected, got)
+	}
+	if cmd.path != r.Path {
+		t.Errorf("Expected %s got %s", r.Path, cmd.path)
+	}
+	if cmd.dir != r.Dir {
+		t.Errorf("Expected %s got %s", r.Dir, cmd.dir)
+	}
+}
+
+func TestRunnerCommandGlobalOptionErrors(t *testing.T) {
+	_, err := (&Runner{}).Command(GlobalOptions{WithOverwrite(true), WithTimelimit(0), WithTimelimit(FramePosition(1, 0, 0).Duration())}, Input("in.mp4"), Output("out.mp4"))
+	if err == nil {
+		t.Fatalf("Expected an error for the invalid time limits")
+	}
+	if got := strings.Count(err.Error(), "unable to apply -timelimit flag"); got != 2 {
+		t.Errorf("Expected 2 -timelimit errors got %d: %v", got, err)
+	}
+}
+
+func TestRunnerEnv(t *testing.T) {
+	os.Setenv("FFMPEG_RUNNER_TEST", "inherited")
+	defer os.Unsetenv("FFMPEG_RUNNER_TEST")
+
+	tests := []struct {
+		Runner   *Runner
+		Expected []string
+		Missing  []string
+	}{
+		{
+			Runner:   &Runner{},
+			Expected: []string{"FFMPEG_RUNNER_TEST=inherited", "AV_LOG_FORCE_NOCOLOR=TRUE"},
+		},
+		{
+			Runner:   &Runner{Env: []string{"TMPDIR=/scratch"}},
+			Expected: []string{"FFMPEG_RUNNER_TEST=inherited", "TMPDIR=/scratch"},
+		},
+		{
+			Runner:   &Runner{Env: []string{"TMPDIR=/scratch"}, IsolateEnv: true},
+			Expected: []string{"TMPDIR=/scratch", "AV_LOG_FORCE_NOCOLOR=TRUE"},
+			Missing:  []string{"FFMPEG_RUNNER_TEST=inherited"},
+		},
+	}
+
+	for _, test := range tests {
+		env := strings.Join(test.Runner.env(), "\n") + "\n"
+		for _, e := range test.Expected {
+			if !strings.Contains(env, e+"\n") {
+				t.Errorf("Expected %s in environment", e)
+			}
+		}
+		for _, e := range test.Missing {
+			if strings.Contains(env, e+"\n") {
+				t.Errorf("Expected %s not in environment", e)
+			}
+		}
+	}
+}
+
+func TestRunnerCommandPixelFormats(t *testing.T) {
+	tests := []struct {
+		Options []FileOption
+		Err     string
+	}{
+		{Options: []FileOption{WithEncoder(VideoStreamSpecifier(0), EncoderLibx264), WithPixelFormat(VideoStreamSpecifier(0), PixelFormatYuv420P)}},
+		{Options: []FileOption{WithEncoder(VideoStreamSpecifier(0), EncoderLibx264), WithPixelFormat(VideoStreamSpecifier(0), PixelFormatRgb24)},
+			Err: "encoder libx264 does not support pixel format rgb24, the closest it supports is yuv444p"},
+		{Options: []FileOption{WithEncoder(VideoStreamSpecifier(-1), EncoderMjpeg), WithPixelFormat(VideoStreamSpecifier(1), PixelFormatYuv420P)},
+			Err: "encoder mjpeg does not support pixel format yuv420p, the closest it supports is yuvj420p"},
+		{Options: []FileOption{WithEncoder(VideoStreamSpecifier(0), EncoderRawvideo), WithPixelFormat(VideoStreamSpecifier(0), PixelFormatRgb24)}},
+		{Options: []FileOption{WithPixelFormat(VideoStreamSpecifier(0), PixelFormatRgb24)}},
+		{Options: []FileOption{WithEncoder(VideoStreamSpecifier(0), EncoderLibx264), WithPixelFormat(VideoStreamSpecifier(-1), PixelFormatYuv420P)}},
+		{Options: []FileOption{WithEncoder(VideoStreamSpecifier(0), EncoderLibx264), WithPixelFormat(VideoStreamSpecifier(-1), PixelFormatRgb24)},
+			Err: "unable to apply -pix_fmt:v flag: encoder libx264 does not support pixel format rgb24"},
+		{Options: []FileOption{WithEncoder(VideoStreamSpecifier(1), EncoderMjpeg), WithPixelFormat(AllStreamSpecifier(), PixelFormatYuv420P)},
+			Err: "unable to apply -pix_fmt: flag: encoder mjpeg does not support pixel format yuv420p"},
+	}
+
+	for _, test := range tests {
+		_, err := (&Runner{}).Command(nil, Input("in.mp4"), Output("out.mp4", test.Options...))
+		if test.Err == "" && err != nil {
+			t.Errorf("unable to create command: %v", err)
+		}
+		if test.Err != "" && (err == nil || !strings.Contains(err.Error(), test.Err)) {
+			t.Errorf("Expected error %q got %v", test.Err, err)
+		}
+	}
+}
+
+func TestRunnerCommandMaps(t *testing.T) {
+	video, audio, other := Input("video.mp4"), Input("audio.wav"), Input("other.mp4")
+
+	tests := []struct {
+		Options  []FileOption
+		Expected string
+		Err      string
+	}{
+		{
+			Options:  []FileOption{WithMap(video, VideoStreamSpecifier(0)), WithMap(audio, AudioStreamSpecifier(-1)), WithEncoder(AudioStreamSpecifier(-1), EncoderAac)},
+			Expected: "-i video.mp4 -i audio.wav -map 0:v:0 -map 1:a -c:a aac out.mp4",
+		},
+		{
+			Options:  []FileOption{WithMap(video, AllStreamSpecifier()), WithNegativeMap(video, SubtitleStreamSpecifier(-1)), WithOptionalMap(audio, AudioStreamSpecifier(1))},
+			Expected: "-i video.mp4 -i audio.wav -map 0 -map -0:s -map 1:a:1? out.mp4",
+		},
+		{
+			Options:  []FileOption{WithMapLabel("[out]"), WithMap(audio, StreamIndexSpecifier(0))},
+			Expected: "-i video.mp4 -i audio.wav -map [out] -map 1:0 out.mp4",
+		},
+		{
+			Options: []FileOption{WithMap(other, AllStreamSpecifier())},
+			Err:     `input file "other.mp4" is not an input of the command`,
+		},
+		{
+			Options: []FileOption{WithNegativeMap(video, SubtitleStreamSpecifier(-1))},
+			Err:     `negative map of input file "video.mp4" follows no map of it`,
+		},
+	}
+
+	for _, test := range tests {
+		cmd, err := (&Runner{BaseFlags: []string{}}).Command(nil, video, audio, Output("out.mp4", test.Options...))
+		if test.Err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.Err) {
+				t.Errorf("Expected error %q got %v", test.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unable to create command: %v", err)
+			continue
+		}
+		if got := strings.Join(cmd.Args, " "); got != test.Expected {
+			t.Errorf("Expected %s got %s", test.Expected, got)
+		}
+	}
+}
+
+func TestRunnerCommandMetadata(t *testing.T) {
+	video, audio, other := Input("video.mp4"), Input("audio.wav"), Input("other.mp4")
+
+	tests := []struct {
+		Options  []FileOption
+		Expected string
+		Err      string
+	}{
+		{
+			Options:  []FileOption{WithMapMetadata(audio, StreamMetadataScope(AudioStreamSpecifier(0)), StreamMetadataScope(AudioStreamSpecifier(1))), WithMetadata(GlobalMetadataScope(), "title", "Film")},
+			Expected: "-i video.mp4 -i audio.wav -map_metadata:s:a:1 1:s:a:0 -metadata title=Film out.mp4",
+		},
+		{
+			Options:  []FileOption{WithMap(video, AllStreamSpecifier()), WithMapMetadata(nil, GlobalMetadataScope(), GlobalMetadataScope()), WithMapChapters(nil)},
+			Expected: "-i video.mp4 -i audio.wav -map 0 -map_metadata -1 -map_chapters -1 out.mp4",
+		},
+		{
+			Options:  []FileOption{WithMapMetadata(audio, GlobalMetadataScope(), GlobalMetadataScope()), WithMapChapters(audio)},
+			Expected: "-i video.mp4 -i audio.wav -map_metadata 1 -map_chapters 1 out.mp4",
+		},
+		{
+			Options: []FileOption{WithMapMetadata(other, GlobalMetadataScope(), GlobalMetadataScope())},
+			Err:     `unable to apply -map_metadata flag: input file "other.mp4" is not an input of the command`,
+		},
+		{
+			Options: []FileOption{WithMapChapters(other)},
+			Err:     `unable to apply -map_chapters flag: input file "other.mp4" is not an input of the command`,
+		},
+	}
+
+	for _, test := range tests {
+		cmd, err := (&Runner{BaseFlags: []string{}}).Command(nil, video, audio, Output("out.mp4", test.Options...))
+		if test.Err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.Err) {
+				t.Errorf("Expected error %q got %v", test.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unable to create command: %v", err)
+			continue
+		}
+		if got := strings.Join(cmd.Args, " "); got != test.Expected {
+			t.Errorf("Expected %s got %s", test.Expected, got)
+		}
+	}
+}
+
+func TestRunnerCommandPositions(t *testing.T) {
+	tests := []struct {
+		Input  []FileOption
+		Output []FileOption
+		Err    string
+	}{
+		{Input: []FileOption{WithSeek(time.Minute)}, Output: []FileOption{WithDuration(10 * time.Second)}},
+		{Output: []FileOption{WithSeek(time.Minute), WithEndPosition(2 * time.Minute)}},
+		{Output: []FileOption{WithDuration(time.Minute), WithEndPosition(2 * time.Minute)},
+			Err: "-t is also set"},
+		{Input: []FileOption{WithEndPosition(time.Minute), WithDuration(time.Minute)},
+			Err: "-t is also set"},
+		{Output: []FileOption{WithSeek(time.Minute), WithEndPosition(time.Minute)},
+			Err: "is not after -ss position 00:01:00"},
+	}
+
+	for _, test := range tests {
+		_, err := (&Runner{}).Command(nil, Input("in.mp4", test.Input...), Output("out.mp4", test.Output...))
+		if test.Err == "" && err != nil {
+			t.Errorf("unable to create command: %v", err)
+		}
+		if test.Err != "" && (err == nil || !strings.Contains(err.Error(), test.Err)) {
+			t.Errorf("Expected error %q got %v", test.Err, err)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package ffmpeg
+
+type sampleFormatInfo struct {
+	bytesPerSample int
+	planar         bool
+}
+
+func (typ SampleFormat) info() sampleFormatInfo {
+	if typ < 0 || int(typ) >= len(sampleFormatInfos) {
+		return sampleFormatInfo{}
+	}
+	return sampleFormatInfos[typ]
+}
+
+// BytesPerSample returns the number of bytes a sample of a channel takes,
+// such as 2 for s16 and 4 for fltp.
+func (typ SampleFormat) BytesPerSample() int {
+	return typ.info().bytesPerSample
+}
+
+// IsPlanar reports whether the channels are stored in separate planes,
+// such as fltp, rather than interleaved, such as flt.
+func (typ SampleFormat) IsPlanar() bool {
+	return typ.info().planar
+}
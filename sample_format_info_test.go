@@ -0,0 +1,29 @@
+package ffmpeg
+
+import "testing"
+
+func TestSampleFormatInfo(t *testing.T) {
+	tests := []struct {
+		SampleFormat   SampleFormat
+		BytesPerSample int
+		Planar         bool
+	}{
+		{SampleFormatU8, 1, false},
+		{SampleFormatS16, 2, false},
+		{SampleFormatS16P, 2, true},
+		{SampleFormatFltp, 4, true},
+		{SampleFormatDbl, 8, false},
+		{SampleFormatS64P, 8, true},
+		{SampleFormat(-1), 0, false},
+	}
+
+	for _, test := range tests {
+		sf := test.SampleFormat
+		if got := sf.BytesPerSample(); got != test.BytesPerSample {
+			t.Errorf("%s: Expected %d bytes per sample got %d", sf, test.BytesPerSample, got)
+		}
+		if got := sf.IsPlanar(); got != test.Planar {
+			t.Errorf("%s: Expected planar %v got %v", sf, test.Planar, got)
+		}
+	}
+}
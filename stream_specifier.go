@@ -1,6 +1,10 @@
 package ffmpeg
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 type StreamType int
 
@@ -11,6 +15,10 @@ const (
 	StreamTypeSubtitle
 	StreamTypeData
 	StreamTypeAttachment
+
+	// StreamTypeVideoNoAttachedPic selects the video streams that are not
+	// attached pictures, such as cover art. Streams are never of this type.
+	StreamTypeVideoNoAttachedPic
 )
 
 func (st StreamType) String() string {
@@ -25,24 +33,212 @@ func (st StreamType) String() string {
 		return "d"
 	case StreamTypeAttachment:
 		return "t"
+	case StreamTypeVideoNoAttachedPic:
+		return "V"
 	default:
 		return ""
 	}
 }
 
+// AllStreamTypes returns all the StreamType constants, StreamTypeAll
+// first.
+func AllStreamTypes() []StreamType {
+	return []StreamType{StreamTypeAll, StreamTypeVideo, StreamTypeAudio, StreamTypeSubtitle, StreamTypeData, StreamTypeAttachment, StreamTypeVideoNoAttachedPic}
+}
+
+// ParseStreamType returns the StreamType named name as in a stream
+// specifier, such as "v". StreamTypeAll is named "".
+func ParseStreamType(name string) (StreamType, error) {
+	var names []string
+	for _, st := range AllStreamTypes() {
+		if st.String() == name {
+			return st, nil
+		}
+		names = append(names, st.String())
+	}
+	return StreamTypeAll, unknownName("stream type", name, names)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (st StreamType) MarshalText() ([]byte, error) {
+	if st < StreamTypeAll || st > StreamTypeVideoNoAttachedPic || st == 0 {
+		return nil, invalidValue("stream type", int(st))
+	}
+	return []byte(st.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (st *StreamType) UnmarshalText(text []byte) error {
+	return st.Set(string(text))
+}
+
+// Set implements flag.Value.
+func (st *StreamType) Set(name string) error {
+	parsed, err := ParseStreamType(name)
+	if err != nil {
+		return err
+	}
+	*st = parsed
+	return nil
+}
+
+// StreamSpecifier selects streams of a file, as appended to the flags of
+// options applying to some of its streams, such as ":v:0" in "-c:v:0".
+//
+// It selects the streams of the type Stream, or of any type with
+// StreamTypeAll, and of those the one at Idx, or all of them when Idx is
+// negative. Instead of by index, it can select them by stream ID, by
+// metadata tag or by whether they are usable, in which case Idx is
+// ignored. Any of those can be restricted to the streams of a program.
 type StreamSpecifier struct {
 	Stream StreamType
 	Idx    int
+
+	// Program is the id of the program the streams are selected from when
+	// HasProgram is set, as in "p:1:v".
+	Program    int
+	HasProgram bool
+
+	// ID selects the stream with this stream ID, such as "0x101" for the
+	// PID of a stream of an MPEG-TS file, as in "#0x101".
+	ID string
+
+	// MetadataKey selects the streams with this metadata tag and, if it is
+	// not "", MetadataValue as its value, as in "m:language:eng".
+	MetadataKey   string
+	MetadataValue string
+
+	// Usable selects the streams whose codec parameters ffmpeg could read,
+	// as in "u".
+	Usable bool
+
+	// negativeIdx is set by StreamIndexSpecifier for a negative index,
+	// which would otherwise select all streams.
+	negativeIdx bool
 }
 
 func (s StreamSpecifier) String() string {
-	if s.Idx == -1 {
-		return ":" + s.Stream.String()
-	} else if s.Stream == StreamTypeAll {
-		return fmt.Sprintf(":%d", s.Idx)
+	var parts []string
+	if s.HasProgram {
+		parts = append(parts, "p", strconv.Itoa(s.Program))
+	}
+	if s.Stream != StreamTypeAll {
+		parts = append(parts, s.Stream.String())
+	}
+
+	switch {
+	case s.ID != "":
+		parts = append(parts, "#"+s.ID)
+	case s.MetadataKey != "":
+		parts = append(parts, "m", s.MetadataKey)
+		if s.MetadataValue != "" {
+			parts = append(parts, s.MetadataValue)
+		}
+	case s.Usable:
+		parts = append(parts, "u")
+	case s.Idx >= 0:
+		parts = append(parts, strconv.Itoa(s.Idx))
+	}
+
+	return ":" + strings.Join(parts, ":")
+}
+
+// check returns an error if the specifier, given to flag, is invalid.
+func (s StreamSpecifier) check(flag string) error {
+	if s.negativeIdx {
+		return fmt.Errorf("unable to apply %s flag: negative stream index %d", flag, s.Idx)
+	}
+	return nil
+}
+
+// InProgram returns the specifier restricted to the streams of the program
+// with the id program.
+func (s StreamSpecifier) InProgram(program int) StreamSpecifier {
+	s.Program, s.HasProgram = program, true
+	return s
+}
+
+// ParseStreamSpecifier parses a stream specifier, such as "v:0",
+// "p:1:a", "#0x101", "i:0x101", "m:language:eng" or "u". A leading colon,
+// as String renders it, is optional, and "" selects all streams.
+func ParseStreamSpecifier(spec string) (StreamSpecifier, error) {
+	s := AllStreamSpecifier()
+	parts := strings.Split(strings.TrimPrefix(spec, ":"), ":")
+	if parts[0] == "" {
+		if len(parts) > 1 {
+			return s, fmt.Errorf("invalid stream specifier %q", spec)
+		}
+		return s, nil
+	}
+
+	index := func(p string) (int, bool) {
+		n, err := strconv.ParseInt(p, 0, 32)
+		return int(n), err == nil && n >= 0
+	}
+
+	if parts[0] == "p" {
+		if len(parts) < 2 {
+			return s, fmt.Errorf("invalid stream specifier %q: no program id", spec)
+		}
+		program, ok := index(parts[1])
+		if !ok {
+			return s, fmt.Errorf("invalid stream specifier %q: invalid program id %q", spec, parts[1])
+		}
+		s = s.InProgram(program)
+		parts = parts[2:]
+	}
+	if len(parts) > 0 {
+		if st, err := ParseStreamType(parts[0]); err == nil && st != StreamTypeAll {
+			s.Stream = st
+			parts = parts[1:]
+		}
+	}
+	if len(parts) == 0 {
+		return s, nil
 	}
 
-	return fmt.Sprintf(":%s:%d", s.Stream, s.Idx)
+	rest := 1
+	switch p := parts[0]; {
+	case strings.HasPrefix(p, "#"):
+		s.ID = p[1:]
+	case p == "i" && len(parts) > 1:
+		s.ID, rest = parts[1], 2
+	case p == "m" && len(parts) > 1:
+		// the value is the rest of the specifier, colons included
+		s.MetadataKey, s.MetadataValue, rest = parts[1], strings.Join(parts[2:], ":"), len(parts)
+	case p == "u":
+		s.Usable = true
+	default:
+		idx, ok := index(p)
+		if !ok {
+			return s, fmt.Errorf("invalid stream specifier %q: unexpected %q", spec, p)
+		}
+		s.Idx = idx
+	}
+	if rest < len(parts) || (s.ID == "" && s.MetadataKey == "" && !s.Usable && s.Idx < 0) {
+		return s, fmt.Errorf("invalid stream specifier %q", spec)
+	}
+	return s, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s StreamSpecifier) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StreamSpecifier) UnmarshalText(text []byte) error {
+	return s.Set(string(text))
+}
+
+// Set implements flag.Value.
+func (s *StreamSpecifier) Set(spec string) error {
+	parsed, err := ParseStreamSpecifier(spec)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
 }
 
 func AllStreamSpecifier() StreamSpecifier {
@@ -52,10 +248,14 @@ func AllStreamSpecifier() StreamSpecifier {
 	}
 }
 
+// StreamIndexSpecifier selects the stream at idx, of any type. The options
+// given a negative idx return an error; use AllStreamSpecifier to select
+// all streams.
 func StreamIndexSpecifier(idx int) StreamSpecifier {
 	return StreamSpecifier{
-		Stream: StreamTypeAll,
-		Idx:    idx,
+		Stream:      StreamTypeAll,
+		Idx:         idx,
+		negativeIdx: idx < 0,
 	}
 }
 
@@ -93,3 +293,34 @@ func AttachmentStreamSpecifier(idx int) StreamSpecifier {
 		Idx:    idx,
 	}
 }
+
+// StreamIDSpecifier selects the stream with the stream ID id, such as
+// "0x101".
+func StreamIDSpecifier(id string) StreamSpecifier {
+	return StreamSpecifier{
+		Stream: StreamTypeAll,
+		Idx:    -1,
+		ID:     id,
+	}
+}
+
+// MetadataStreamSpecifier selects the streams with the metadata tag key
+// and, if it is not "", value as its value.
+func MetadataStreamSpecifier(key, value string) StreamSpecifier {
+	return StreamSpecifier{
+		Stream:        StreamTypeAll,
+		Idx:           -1,
+		MetadataKey:   key,
+		MetadataValue: value,
+	}
+}
+
+// UsableStreamSpecifier selects the streams whose codec parameters ffmpeg
+// could read.
+func UsableStreamSpecifier() StreamSpecifier {
+	return StreamSpecifier{
+		Stream: StreamTypeAll,
+		Idx:    -1,
+		Usable: true,
+	}
+}
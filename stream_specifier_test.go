@@ -1,6 +1,9 @@
 package ffmpeg
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestStreamSpecifier(t *testing.T) {
 	tests := []struct {
@@ -32,6 +35,17 @@ func TestStreamSpecifier(t *testing.T) {
 		{Specifier: AudioStreamSpecifier(0), Expected: ":a:0"},
 		{Specifier: DataStreamSpecifier(1), Expected: ":d:1"},
 		{Specifier: AttachmentStreamSpecifier(5), Expected: ":t:5"},
+		{Specifier: StreamSpecifier{Stream: StreamTypeVideoNoAttachedPic, Idx: -1}, Expected: ":V"},
+		{Specifier: VideoStreamSpecifier(-1).InProgram(1), Expected: ":p:1:v"},
+		{Specifier: AudioStreamSpecifier(2).InProgram(0), Expected: ":p:0:a:2"},
+		{Specifier: StreamIndexSpecifier(3).InProgram(1), Expected: ":p:1:3"},
+		{Specifier: AllStreamSpecifier().InProgram(1), Expected: ":p:1"},
+		{Specifier: StreamIDSpecifier("0x101"), Expected: ":#0x101"},
+		{Specifier: MetadataStreamSpecifier("language", "eng"), Expected: ":m:language:eng"},
+		{Specifier: MetadataStreamSpecifier("language", ""), Expected: ":m:language"},
+		{Specifier: AudioStreamSpecifier(-1).InProgram(2), Expected: ":p:2:a"},
+		{Specifier: UsableStreamSpecifier(), Expected: ":u"},
+		{Specifier: StreamSpecifier{Stream: StreamTypeSubtitle, ID: "3"}, Expected: ":s:#3"},
 	}
 
 	for _, test := range tests {
@@ -40,3 +54,94 @@ func TestStreamSpecifier(t *testing.T) {
 		}
 	}
 }
+
+func TestStreamIndexSpecifierNegative(t *testing.T) {
+	spec := StreamIndexSpecifier(-1)
+	opts := []FileOption{
+		WithCodec(spec, CodecH264),
+		WithSampleRate(spec, 48000),
+		WithSize(spec, 640, 480),
+		WithMap(&File{typ: fileTypeInput}, spec),
+	}
+	for i, opt := range opts {
+		f := &File{typ: fileTypeOutput}
+		err := opt(f)
+		if err == nil || !strings.Contains(err.Error(), "negative stream index -1") {
+			t.Errorf("%d: Expected negative stream index error got %v", i, err)
+		}
+		if len(f.options) != 0 || len(f.maps) != 0 {
+			t.Errorf("%d: Expected no flags got %v", i, f.options)
+		}
+	}
+}
+
+func TestParseStreamSpecifier(t *testing.T) {
+	tests := []struct {
+		Input    string
+		Expected StreamSpecifier
+		String   string
+		Err      bool
+	}{
+		{Input: "", Expected: AllStreamSpecifier(), String: ":"},
+		{Input: ":", Expected: AllStreamSpecifier(), String: ":"},
+		{Input: "1", Expected: StreamIndexSpecifier(1), String: ":1"},
+		{Input: ":v:0", Expected: VideoStreamSpecifier(0), String: ":v:0"},
+		{Input: "a", Expected: AudioStreamSpecifier(-1), String: ":a"},
+		{Input: "V", Expected: StreamSpecifier{Stream: StreamTypeVideoNoAttachedPic, Idx: -1}, String: ":V"},
+		{Input: "p:1", Expected: AllStreamSpecifier().InProgram(1), String: ":p:1"},
+		{Input: "p:1:v", Expected: VideoStreamSpecifier(-1).InProgram(1), String: ":p:1:v"},
+		{Input: "p:1:a:2", Expected: AudioStreamSpecifier(2).InProgram(1), String: ":p:1:a:2"},
+		{Input: "p:0:3", Expected: StreamIndexSpecifier(3).InProgram(0), String: ":p:0:3"},
+		{Input: "#0x101", Expected: StreamIDSpecifier("0x101"), String: ":#0x101"},
+		{Input: "i:0x101", Expected: StreamIDSpecifier("0x101"), String: ":#0x101"},
+		{Input: "m:language:eng", Expected: MetadataStreamSpecifier("language", "eng"), String: ":m:language:eng"},
+		{Input: "m:language", Expected: MetadataStreamSpecifier("language", ""), String: ":m:language"},
+		{Input: "m:title:part:1", Expected: MetadataStreamSpecifier("title", "part:1"), String: ":m:title:part:1"},
+		{Input: "s:m:language:eng", Expected: StreamSpecifier{Stream: StreamTypeSubtitle, Idx: -1, MetadataKey: "language", MetadataValue: "eng"}, String: ":s:m:language:eng"},
+		{Input: "u", Expected: UsableStreamSpecifier(), String: ":u"},
+		{Input: "x", Err: true},
+		{Input: "v:x", Err: true},
+		{Input: "v:0:1", Err: true},
+		{Input: "-1", Err: true},
+		{Input: "p", Err: true},
+		{Input: "p:x:v", Err: true},
+		{Input: "#", Err: true},
+		{Input: "i", Err: true},
+		{Input: "m", Err: true},
+		{Input: "::", Err: true},
+		{Input: "u:1", Err: true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseStreamSpecifier(test.Input)
+		if test.Err {
+			if err == nil {
+				t.Errorf("%q: Expected error got %+v", test.Input, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unable to parse: %v", test.Input, err)
+			continue
+		}
+		if got != test.Expected {
+			t.Errorf("%q: Expected %+v got %+v", test.Input, test.Expected, got)
+		}
+		if got.String() != test.String {
+			t.Errorf("%q: Expected %s got %s", test.Input, test.String, got.String())
+		}
+		if again, err := ParseStreamSpecifier(got.String()); err != nil || again != got {
+			t.Errorf("%q: %s does not round-trip: %+v %v", test.Input, got, again, err)
+		}
+	}
+}
+
+func TestStreamSpecifierSet(t *testing.T) {
+	var s StreamSpecifier
+	if err := s.Set("p:1:v:0"); err != nil || s != VideoStreamSpecifier(0).InProgram(1) {
+		t.Errorf("Unexpected %+v %v", s, err)
+	}
+	if err := s.Set("v:"); err == nil || !strings.Contains(err.Error(), `invalid stream specifier "v:"`) {
+		t.Errorf("Expected invalid stream specifier error got %v", err)
+	}
+}